@@ -0,0 +1,30 @@
+package eventproto
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/marboga/gametimehero/utils/recurrence"
+)
+
+// Rule converts the recurrence proto model to a recurrence rule, a nil recurrence gives an empty rule.
+// The proto frequencies and weekdays share the values of the recurrence and time packages.
+func (r *Recurrence) Rule() recurrence.Rule {
+	rule := recurrence.Rule{
+		Frequency: recurrence.Frequency(r.GetFrequency()),
+		Interval:  int(r.GetInterval()),
+		ByDay:     make([]time.Weekday, len(r.GetByDay())),
+		Count:     int(r.GetCount()),
+	}
+
+	for i, day := range r.GetByDay() {
+		rule.ByDay[i] = time.Weekday(day)
+	}
+
+	if r.GetUntil() != nil {
+		rule.Until, _ = ptypes.Timestamp(r.GetUntil())
+	}
+
+	return rule
+}
@@ -6,3 +6,4 @@ package proto
 //go:generate protoc --proto_path=$GOPATH/src:. --micro_out=$GOPATH/src --go_out=$GOPATH/src $GOPATH/src/github.com/marboga/gametimehero/proto/common/types.proto
 
 //go:generate protoc --proto_path=$GOPATH/src:. --micro_out=$GOPATH/src --go_out=$GOPATH/src $GOPATH/src/github.com/marboga/gametimehero/proto/account-svc/account.proto
+//go:generate protoc --proto_path=$GOPATH/src:. --micro_out=$GOPATH/src --go_out=$GOPATH/src $GOPATH/src/github.com/marboga/gametimehero/proto/event-svc/event.proto
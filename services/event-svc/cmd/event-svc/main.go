@@ -1,6 +1,9 @@
 package main
 
 import (
+	// Embed the time zone database, the runtime image doesn't provide one.
+	_ "time/tzdata"
+
 	// We need these imports to register NATS broker, registry, and transport.
 	// This type is defined through evars in docker-compose.yaml file.
 	_ "github.com/micro/go-plugins/broker/nats/v2"
@@ -8,7 +11,7 @@ import (
 	_ "github.com/micro/go-plugins/transport/nats/v2"
 	"github.com/sirupsen/logrus"
 
-	"github.com/marboga/gametimehero/services/account-svc/microservice"
+	"github.com/marboga/gametimehero/services/event-svc/microservice"
 )
 
 // Version may be changed during build via --ldflags parameter
@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/signature"
+)
+
+// checkInScope is signed together with the event ID, the expiry and the nonce,
+// so the check-in codes can't be used for anything else.
+const checkInScope = "check-in"
+
+// CreateCheckInCode implements Controller interface.
+// The code is the expiry and a random nonce followed by their signature, so it's short enough for a QR code.
+// Each code checks in a single attendee, so the display at the venue asks for a new one after each check-in.
+func (d *controller) CreateCheckInCode(ctx context.Context, id string, organizerID string) (*eventproto.CheckInCode, error) {
+	event, err := d.store.ReadEvent(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+	}
+
+	if err := checkOrganizer(event, organizerID); err != nil {
+		return nil, err
+	}
+
+	if err := checkCheckInOpen(event); err != nil {
+		return nil, err
+	}
+
+	// The nonce tells apart the codes created in the same second.
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return nil, errors.Wrap(err, "unable to generate the check-in code nonce")
+	}
+	nonce := strconv.FormatUint(binary.BigEndian.Uint64(random), 36)
+
+	expiresAt := time.Now().Add(d.checkInCodeTTL)
+	expiry := strconv.FormatInt(expiresAt.Unix(), 36)
+
+	code := &eventproto.CheckInCode{
+		EventId: event.GetId(),
+		Code:    expiry + "." + nonce + "." + signature.Sign(d.checkInSecret, checkInScope, event.GetId(), expiry, nonce),
+	}
+	code.ExpiresAt, _ = ptypes.TimestampProto(time.Unix(expiresAt.Unix(), 0))
+
+	return code, nil
+}
+
+// CheckIn implements Controller interface.
+// Only the attendees of a published event can check in. Checking in twice returns the first attendance record.
+// A check-in code can't be used again by another attendee.
+func (d *controller) CheckIn(ctx context.Context, id string, user *eventproto.User, code string, organizerID string) (*eventproto.Attendance, error) {
+	if user.GetId() == "" {
+		return nil, ErrUserRequired
+	}
+
+	if code == "" && organizerID == "" {
+		return nil, ErrCheckInProofRequired
+	}
+
+	event, err := d.store.ReadEvent(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+	}
+
+	attendance := &eventproto.Attendance{
+		EventId:     event.GetId(),
+		User:        user,
+		CheckedInAt: ptypes.TimestampNow(),
+		Method:      eventproto.Attendance_ORGANIZER,
+	}
+
+	if code != "" {
+		if !d.validCheckInCode(event.GetId(), code) {
+			return nil, ErrInvalidCheckInCode
+		}
+		attendance.Method = eventproto.Attendance_CODE
+		attendance.Code = code
+	} else if err := checkOrganizer(event, organizerID); err != nil {
+		return nil, err
+	}
+
+	if err := checkCheckInOpen(event); err != nil {
+		return nil, err
+	}
+
+	if userIndex(event.GetAttendees(), user.GetId()) < 0 {
+		return nil, ErrNotAttending
+	}
+
+	attendance, err = d.store.CreateAttendance(ctx, attendance)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create attendance in the store layer for event with ID '%s'", id)
+	}
+
+	// The store returns the record of another user if the code was already used.
+	if attendance.GetUser().GetId() != user.GetId() {
+		return nil, ErrCheckInCodeUsed
+	}
+
+	return attendance, nil
+}
+
+// ListAttendance implements Controller interface.
+// The attendance of private events can only be listed by their members.
+func (d *controller) ListAttendance(ctx context.Context, id string, viewerID string) (*eventproto.AttendanceReport, error) {
+	event, err := d.ReadEvent(ctx, id, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendances, err := d.store.ListAttendances(ctx, event.GetId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list attendances in the store layer for event with ID '%s'", id)
+	}
+
+	report := &eventproto.AttendanceReport{
+		EventId:     event.GetId(),
+		Attendances: attendances,
+	}
+
+	checkedIn := make(map[string]bool, len(attendances))
+	for _, attendance := range attendances {
+		checkedIn[attendance.GetUser().GetId()] = true
+	}
+
+	for _, attendee := range event.GetAttendees() {
+		if !checkedIn[attendee.GetId()] {
+			report.NoShows = append(report.NoShows, attendee)
+		}
+	}
+
+	return report, nil
+}
+
+// ReadUserAttendance implements Controller interface.
+// Only completed events are counted, so the events which didn't happen yet are never counted as no-shows.
+func (d *controller) ReadUserAttendance(ctx context.Context, userID string) (*eventproto.UserAttendance, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	attendances, err := d.store.ListUserAttendances(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list attendances in the store layer for user with ID '%s'", userID)
+	}
+
+	checkedIn := make(map[string]bool, len(attendances))
+	for _, attendance := range attendances {
+		checkedIn[attendance.GetEventId()] = true
+	}
+
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	result := &eventproto.UserAttendance{
+		UserId: userID,
+	}
+	for _, event := range events {
+		if event.GetStatus() != eventproto.Event_COMPLETED || userIndex(event.GetAttendees(), userID) < 0 {
+			continue
+		}
+
+		if checkedIn[event.GetId()] {
+			result.Attended++
+		} else {
+			result.NoShows++
+		}
+	}
+
+	return result, nil
+}
+
+// validCheckInCode returns true if the given code was signed for the event with the given ID and isn't expired.
+func (d *controller) validCheckInCode(eventID string, code string) bool {
+	parts := strings.SplitN(code, ".", 3)
+	if len(parts) != 3 {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[0], 36, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return false
+	}
+
+	return signature.Verify(d.checkInSecret, parts[2], checkInScope, eventID, parts[0], parts[1])
+}
+
+// checkOrganizer returns an error if the user with the given ID isn't the organizer of the given event.
+func checkOrganizer(event *eventproto.Event, organizerID string) error {
+	if organizerID == "" || event.GetCreator().GetId() != organizerID {
+		return ErrNotOrganizer
+	}
+
+	return nil
+}
+
+// checkCheckInOpen returns an error if the attendees can't check in the given event.
+func checkCheckInOpen(event *eventproto.Event) error {
+	switch event.GetStatus() {
+	case eventproto.Event_PUBLISHED:
+		return nil
+	case eventproto.Event_COMPLETED:
+		return ErrEventCompleted
+	default:
+		return ErrEventNotPublished
+	}
+}
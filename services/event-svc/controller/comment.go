@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+const (
+	// maxCommentLength is the maximum number of characters of a comment.
+	maxCommentLength = 2000
+
+	// defaultCommentPageSize is the number of comments of a page when no limit is given.
+	defaultCommentPageSize = 50
+
+	// maxCommentPageSize is the maximum number of comments of a page.
+	maxCommentPageSize = 200
+)
+
+// CreateComment implements Controller interface.
+// Anybody can comment an existing event, but only the members of a private event can comment it.
+func (d *controller) CreateComment(ctx context.Context, eventID string, author *eventproto.User, text string) (*eventproto.Comment, error) {
+	if author.GetId() == "" {
+		return nil, ErrUserRequired
+	}
+
+	text, err := commentText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// The thread of a private event is as hidden as the event.
+	if _, err := d.ReadEvent(ctx, eventID, author.GetId()); err != nil {
+		return nil, err
+	}
+
+	comment, err := d.store.CreateComment(ctx, &eventproto.Comment{
+		EventId: eventID,
+		Author:  author,
+		Text:    text,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create comment in the store layer for event with ID '%s'", eventID)
+	}
+
+	return comment, nil
+}
+
+// ListComments implements Controller interface.
+// The page token is the creation time of the last comment of the previous page,
+// so deleting comments never shifts the following pages. The thread of a private event is only listed to its members.
+func (d *controller) ListComments(ctx context.Context, eventID string, viewerID string, pageToken string, limit int64) ([]*eventproto.Comment, string, error) {
+	if limit < 0 {
+		return nil, "", ErrInvalidLimit
+	} else if limit == 0 {
+		limit = defaultCommentPageSize
+	} else if limit > maxCommentPageSize {
+		limit = maxCommentPageSize
+	}
+
+	var after time.Time
+	if pageToken != "" {
+		nanos, err := strconv.ParseInt(pageToken, 36, 64)
+		if err != nil {
+			return nil, "", ErrInvalidPageToken
+		}
+		after = time.Unix(0, nanos)
+	}
+
+	if _, err := d.ReadEvent(ctx, eventID, viewerID); err != nil {
+		return nil, "", err
+	}
+
+	// List one more comment to know if there is a next page.
+	comments, err := d.store.ListComments(ctx, eventID, after, int(limit)+1)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "unable to list comments in the store layer for event with ID '%s'", eventID)
+	}
+
+	if len(comments) <= int(limit) {
+		return comments, "", nil
+	}
+
+	comments = comments[:limit]
+	last, _ := ptypes.Timestamp(comments[limit-1].GetCreatedAt())
+
+	return comments, strconv.FormatInt(last.UnixNano(), 36), nil
+}
+
+// UpdateComment implements Controller interface.
+// Only the author can edit a comment.
+func (d *controller) UpdateComment(ctx context.Context, id string, userID string, text string) (*eventproto.Comment, error) {
+	text, err := commentText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := d.store.ModifyComment(ctx, id, func(comment *eventproto.Comment) error {
+		if userID == "" || comment.GetAuthor().GetId() != userID {
+			return ErrNotAuthor
+		}
+
+		comment.Text = text
+		comment.Edited = true
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update comment in the store layer with ID '%s'", id)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment implements Controller interface.
+// The author can delete a comment, and the creator of the event can delete any comment to moderate the thread.
+func (d *controller) DeleteComment(ctx context.Context, id string, userID string) error {
+	comment, err := d.store.ReadComment(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read comment in the store layer with ID '%s'", id)
+	}
+
+	if userID == "" {
+		return ErrNotAuthor
+	}
+
+	if comment.GetAuthor().GetId() != userID {
+		event, err := d.store.ReadEvent(ctx, comment.GetEventId())
+		if err != nil {
+			return errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", comment.GetEventId())
+		}
+
+		if event.GetCreator().GetId() != userID {
+			return ErrNotAuthor
+		}
+	}
+
+	if err := d.store.DeleteComment(ctx, id); err != nil {
+		return errors.Wrapf(err, "unable to delete comment in the store layer with ID '%s'", id)
+	}
+
+	return nil
+}
+
+// commentText returns the given comment text without surrounding spaces, or an error if it's empty or too long.
+func commentText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", ErrTextRequired
+	}
+
+	if utf8.RuneCountInString(text) > maxCommentLength {
+		return "", ErrCommentTooLong
+	}
+
+	return text, nil
+}
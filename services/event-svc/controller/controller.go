@@ -4,6 +4,9 @@ package controller
 
 import (
 	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
 
 	eventproto "github.com/marboga/gametimehero/proto/event-svc"
 )
@@ -20,15 +23,219 @@ type Controller interface {
 	// CreateEvent creates a new Event by the given input.
 	CreateEvent(context.Context, *eventproto.Event) (*eventproto.Event, error)
 
-	// ReadEvent reads an existing Event by its ID.
-	ReadEvent(context.Context, string) (*eventproto.Event, error)
+	// ReadEvent reads an existing Event by its ID for the user with the given viewer ID.
+	ReadEvent(ctx context.Context, id string, viewerID string) (*eventproto.Event, error)
 
-	// ListEvents lists all events.
-	ListEvents(context.Context) ([]*eventproto.Event, error)
+	// ListEvents lists events matching the given request.
+	ListEvents(context.Context, *eventproto.ListEventsRequest) ([]*eventproto.Event, error)
 
 	// UpdateEvent updates an existing Event by its ID using the given input.
 	UpdateEvent(context.Context, string, *eventproto.Event) (*eventproto.Event, error)
 
 	// DeleteEvent deletes an existing Event by its ID.
 	DeleteEvent(context.Context, string) error
+
+	// ImportEvents creates Events from the records of a CSV or an iCalendar file.
+	// Records duplicating an existing Event are skipped, invalid records fail without stopping the import.
+	ImportEvents(context.Context, *eventproto.ImportEventsRequest) (*eventproto.ImportReport, error)
+
+	// CloneEvent creates a draft copy of the configuration of an existing Event found by its ID at the given start time.
+	// Only the organizer of the Event can clone it, its attendees are carried over if keepAttendees is true.
+	CloneEvent(ctx context.Context, id string, organizerID string, startTime *timestamp.Timestamp, keepAttendees bool) (*eventproto.Event, error)
+
+	// JoinEvent adds the given user to the attendees of an existing Event found by its ID.
+	// The user is put on the waitlist if the Event is full.
+	// The user can't join an Event overlapping the user's schedule unless force is true.
+	JoinEvent(ctx context.Context, id string, user *eventproto.User, force bool) (*eventproto.Event, error)
+
+	// LeaveEvent removes the user with the given ID from the attendees or the waitlist
+	// of an existing Event found by its ID.
+	LeaveEvent(context.Context, string, string) (*eventproto.Event, error)
+
+	// ReadWaitlistPosition reads the waitlist position of the user with the given ID
+	// for an existing Event found by its ID.
+	ReadWaitlistPosition(context.Context, string, string) (*eventproto.WaitlistPosition, error)
+
+	// ListUserEvents lists the events created or joined by the user with the given ID.
+	ListUserEvents(context.Context, string) ([]*eventproto.Event, error)
+
+	// ReadUserSchedule merges the events created, joined or waited for by the user with the given ID
+	// into one timeline of the events and occurrences which overlap the given time window.
+	ReadUserSchedule(ctx context.Context, userID string, startAfter, startBefore *timestamp.Timestamp) ([]*eventproto.ScheduleEntry, error)
+
+	// ReadCalendarFeed reads the calendar feed of the user with the given ID.
+	ReadCalendarFeed(context.Context, string) (*eventproto.CalendarFeed, error)
+
+	// RotateCalendarFeed gives a new salt to the calendar feed of the user with the given ID,
+	// which revokes the feed URLs given out before.
+	RotateCalendarFeed(context.Context, string) (*eventproto.CalendarFeed, error)
+
+	// ModifyOccurrence cancels or modifies a single occurrence of an existing recurring Event found by its ID.
+	ModifyOccurrence(context.Context, string, *eventproto.OccurrenceException) (*eventproto.Event, error)
+
+	// CancelOccurrence cancels the occurrence starting at the given time of an existing recurring Event found by its ID.
+	CancelOccurrence(context.Context, string, *timestamp.Timestamp) (*eventproto.Event, error)
+
+	// PublishEvent publishes an existing draft Event found by its ID, so users can join it.
+	PublishEvent(context.Context, string) (*eventproto.Event, error)
+
+	// CancelEvent cancels an existing published Event found by its ID for the given reason.
+	CancelEvent(context.Context, string, string) (*eventproto.Event, error)
+
+	// CompleteEvent completes an existing published Event found by its ID. Completed events can't be modified.
+	CompleteEvent(context.Context, string) (*eventproto.Event, error)
+
+	// DecideEvents confirms or cancels the published Events whose decision deadline passed at the given time,
+	// depending on whether they reached their minimum number of attendees.
+	DecideEvents(ctx context.Context, now time.Time) error
+
+	// SendReminders publishes the reminders of the upcoming published Events which are due at the given time
+	// to their attendees, the configured offsets before they start.
+	SendReminders(ctx context.Context, now time.Time) error
+
+	// ClaimEquipment commits the given attendee to bring the given quantity of an equipment item
+	// of an existing Event found by its ID.
+	ClaimEquipment(context.Context, string, string, *eventproto.User, int64) (*eventproto.Event, error)
+
+	// ReleaseEquipment releases the claim of the user with the given ID on an equipment item
+	// of an existing Event found by its ID.
+	ReleaseEquipment(context.Context, string, string, string) (*eventproto.Event, error)
+
+	// InviteUsers invites the users with the given IDs to an existing Event found by its ID.
+	// Only the organizer with the given ID can invite users.
+	InviteUsers(ctx context.Context, id string, organizerID string, userIDs []string) (*eventproto.Event, error)
+
+	// CreateInviteLink creates an expiring link any user can follow to join an existing Event found by its ID.
+	// Only the organizer with the given ID can create links.
+	CreateInviteLink(ctx context.Context, id string, organizerID string) (*eventproto.InviteLink, error)
+
+	// AcceptInvitation accepts the invitation of the given user, or the given invite link token,
+	// and adds the user to the attendees of an existing Event found by its ID.
+	AcceptInvitation(ctx context.Context, id string, user *eventproto.User, token string, force bool) (*eventproto.Event, error)
+
+	// DeclineInvitation declines the invitation of the user with the given ID to an existing Event found by its ID.
+	DeclineInvitation(ctx context.Context, id string, userID string) (*eventproto.Event, error)
+
+	// ListUserInvitations lists the pending invitations of the user with the given ID.
+	ListUserInvitations(context.Context, string) ([]*eventproto.Invitation, error)
+
+	// GenerateTeams splits the attendees of an existing Event into balanced teams and stores the lineup on the Event.
+	GenerateTeams(context.Context, *eventproto.GenerateTeamsRequest) (*eventproto.Event, error)
+
+	// RecordResult records the scores of an existing Event which is over, until its result is confirmed.
+	RecordResult(context.Context, *eventproto.RecordResultRequest) (*eventproto.MatchResult, error)
+
+	// ReadResult reads the result of an existing Event found by its ID to the user with the given ID.
+	ReadResult(context.Context, string, string) (*eventproto.MatchResult, error)
+
+	// CorrectResult changes the recorded result of an existing Event and keeps the change in its audit trail.
+	CorrectResult(context.Context, *eventproto.CorrectResultRequest) (*eventproto.MatchResult, error)
+
+	// CreateCheckInCode creates a short-lived code which an attendee of an existing published Event found by its ID
+	// uses to check in. Each code checks in a single attendee. Only the organizer with the given ID can create it.
+	CreateCheckInCode(context.Context, string, string) (*eventproto.CheckInCode, error)
+
+	// CheckIn records that the given attendee showed up at an existing Event found by its ID.
+	// The attendee proves it with the given check-in code, or the organizer with the given ID marks the attendee.
+	CheckIn(context.Context, string, *eventproto.User, string, string) (*eventproto.Attendance, error)
+
+	// ListAttendance lists who showed up at an existing Event found by its ID, and the attendees who didn't,
+	// to the user with the given ID.
+	ListAttendance(context.Context, string, string) (*eventproto.AttendanceReport, error)
+
+	// ReadUserAttendance counts the completed Events the user with the given ID joined, by whether the user showed up.
+	ReadUserAttendance(context.Context, string) (*eventproto.UserAttendance, error)
+
+	// SearchEventsNear lists the events located within the given radius in kilometers around the given location,
+	// nearest first. Only the events visible to the user with the given viewer ID are listed.
+	SearchEventsNear(ctx context.Context, center *eventproto.LatLong, radiusKm float64, viewerID string) ([]*eventproto.EventDistance, error)
+
+	// SearchEvents lists the events whose name, description or type match every word of the given query,
+	// the most relevant first. Only the events visible to the user with the given viewer ID are listed.
+	SearchEvents(context.Context, *eventproto.SearchEventsRequest) ([]*eventproto.EventMatch, error)
+
+	// CreateEventType adds a new EventType to the catalog by the given input.
+	CreateEventType(context.Context, *eventproto.EventType) (*eventproto.EventType, error)
+
+	// ReadEventType reads an existing EventType by its ID.
+	ReadEventType(context.Context, string) (*eventproto.EventType, error)
+
+	// ListEventTypes lists the whole EventType catalog.
+	ListEventTypes(context.Context) ([]*eventproto.EventType, error)
+
+	// UpdateEventType updates an existing EventType by its ID using the given input.
+	UpdateEventType(context.Context, string, *eventproto.EventType) (*eventproto.EventType, error)
+
+	// DeleteEventType deletes an existing EventType by its ID.
+	DeleteEventType(context.Context, string) error
+
+	// CreateVenue creates a new Venue by the given input.
+	CreateVenue(context.Context, *eventproto.Venue) (*eventproto.Venue, error)
+
+	// ReadVenue reads an existing Venue by its ID.
+	ReadVenue(context.Context, string) (*eventproto.Venue, error)
+
+	// ListVenues lists all Venues.
+	ListVenues(context.Context) ([]*eventproto.Venue, error)
+
+	// UpdateVenue updates an existing Venue by its ID using the given input.
+	UpdateVenue(context.Context, string, *eventproto.Venue) (*eventproto.Venue, error)
+
+	// DeleteVenue deletes an existing Venue by its ID.
+	DeleteVenue(context.Context, string) error
+
+	// CreateTemplate creates a new EventTemplate by the given input.
+	// The configuration is copied from the Event with the given ID if it's not empty.
+	CreateTemplate(ctx context.Context, input *eventproto.EventTemplate, eventID string) (*eventproto.EventTemplate, error)
+
+	// ReadTemplate reads an existing EventTemplate by its ID.
+	ReadTemplate(context.Context, string) (*eventproto.EventTemplate, error)
+
+	// ListTemplates lists the EventTemplates of the given owner.
+	ListTemplates(context.Context, string) ([]*eventproto.EventTemplate, error)
+
+	// UpdateTemplate updates an existing EventTemplate by its ID using the given input.
+	UpdateTemplate(context.Context, string, *eventproto.EventTemplate) (*eventproto.EventTemplate, error)
+
+	// DeleteTemplate deletes an existing EventTemplate by its ID on behalf of its owner.
+	DeleteTemplate(ctx context.Context, id string, ownerID string) error
+
+	// CreateEventFromTemplate creates a draft Event at the given start time from an existing EventTemplate found by its ID.
+	CreateEventFromTemplate(ctx context.Context, id string, creator *eventproto.User, startTime *timestamp.Timestamp) (*eventproto.Event, error)
+
+	// CreateComment adds a comment of the given author to the thread of an existing Event found by its ID.
+	CreateComment(context.Context, string, *eventproto.User, string) (*eventproto.Comment, error)
+
+	// ListComments lists a page of the thread of an Event found by its ID to the user with the given ID, oldest first.
+	// Returns the token of the next page, which is empty on the last page.
+	ListComments(ctx context.Context, eventID string, viewerID string, pageToken string, limit int64) ([]*eventproto.Comment, string, error)
+
+	// UpdateComment changes the text of an existing Comment found by its ID on behalf of the user with the given ID.
+	UpdateComment(context.Context, string, string, string) (*eventproto.Comment, error)
+
+	// DeleteComment deletes an existing Comment found by its ID on behalf of the user with the given ID.
+	DeleteComment(context.Context, string, string) error
+
+	// CreateLeague creates a new League with its teams by the given input.
+	CreateLeague(context.Context, *eventproto.League) (*eventproto.League, error)
+
+	// ReadLeague reads an existing League by its ID.
+	ReadLeague(context.Context, string) (*eventproto.League, error)
+
+	// ListLeagues lists all Leagues.
+	ListLeagues(context.Context) ([]*eventproto.League, error)
+
+	// CreateSeason creates a new Season of an existing League by the given input.
+	// Only the organizer of the league with the given ID can add seasons.
+	CreateSeason(ctx context.Context, input *eventproto.Season, organizerID string) (*eventproto.Season, error)
+
+	// ReadSeason reads an existing Season by its ID.
+	ReadSeason(context.Context, string) (*eventproto.Season, error)
+
+	// GenerateFixtures creates the round-robin fixtures of an existing Season as events, spread over the given venues
+	// and dates. Only the organizer of the league can generate them, once per season.
+	GenerateFixtures(context.Context, *eventproto.GenerateFixturesRequest) (*eventproto.Season, error)
+
+	// ReadStandings derives the table of an existing Season found by its ID from the results of its fixtures.
+	ReadStandings(context.Context, string) ([]*eventproto.Standing, error)
 }
@@ -0,0 +1,358 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/v2"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/marboga/gametimehero/proto/common"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/event-svc/store"
+	"github.com/marboga/gametimehero/utils/geo"
+)
+
+// Options contains options to create a controller.
+type Options struct {
+	Store store.Store
+	Log   *logrus.Logger
+
+	// Promotions publishes the users promoted from the waitlist of an event.
+	Promotions micro.Event
+
+	// Decisions publishes the events confirmed or cancelled at their decision deadline.
+	Decisions micro.Event
+
+	// Reminders publishes the reminders of the upcoming events to their attendees.
+	Reminders micro.Event
+
+	// ReminderOffsets are the times before the start of an event its reminders are sent. No reminder is sent if empty.
+	ReminderOffsets []time.Duration
+
+	// Capacity is the default maximum number of attendees of an event. Zero means unlimited.
+	Capacity int64
+
+	// CheckInSecret signs the check-in codes.
+	CheckInSecret string
+
+	// CheckInCodeTTL is the lifetime of the check-in codes.
+	CheckInCodeTTL time.Duration
+
+	// ResultConfirmationWindow is the time during which a recorded result can be changed.
+	ResultConfirmationWindow time.Duration
+
+	// InviteSecret signs the invite links.
+	InviteSecret string
+
+	// InviteLinkTTL is the lifetime of the invite links.
+	InviteLinkTTL time.Duration
+}
+
+// controller implements the business/controller logic of the service.
+type controller struct {
+	store                    store.Store
+	log                      *logrus.Logger
+	promotions               micro.Event
+	decisions                micro.Event
+	reminders                micro.Event
+	reminderOffsets          []time.Duration
+	capacity                 int64
+	checkInSecret            string
+	checkInCodeTTL           time.Duration
+	resultConfirmationWindow time.Duration
+	inviteSecret             string
+	inviteLinkTTL            time.Duration
+}
+
+// New is the constructor of controller.
+func New(opts *Options) Controller {
+	return &controller{
+		store:                    opts.Store,
+		log:                      opts.Log,
+		promotions:               opts.Promotions,
+		decisions:                opts.Decisions,
+		reminders:                opts.Reminders,
+		reminderOffsets:          opts.ReminderOffsets,
+		capacity:                 opts.Capacity,
+		checkInSecret:            opts.CheckInSecret,
+		checkInCodeTTL:           opts.CheckInCodeTTL,
+		resultConfirmationWindow: opts.ResultConfirmationWindow,
+		inviteSecret:             opts.InviteSecret,
+		inviteLinkTTL:            opts.InviteLinkTTL,
+	}
+}
+
+// HealthCheck implements Controller interface.
+func (d *controller) HealthCheck() error {
+	return nil
+}
+
+// CreateEvent implements Controller interface.
+// The type of the event must be in the catalog, and the unset fields are filled with the defaults of the type.
+// The location and the capacity come from the venue if any, and the event can't overlap another one at the venue.
+// The attendee count is always derived from the list of attendees.
+func (d *controller) CreateEvent(ctx context.Context, input *eventproto.Event) (*eventproto.Event, error) {
+	if err := d.applyVenue(ctx, input); err != nil {
+		return nil, err
+	}
+
+	if err := d.applyEventType(ctx, input); err != nil {
+		return nil, err
+	}
+
+	if err := validateEvent(input); err != nil {
+		return nil, err
+	}
+
+	input.AttendeeCount = attendeeCount(input)
+	input.OriginalStartTime = nil
+	input.Status = eventproto.Event_DRAFT
+	input.CancellationReason = ""
+	input.ConfirmedAt = nil
+	input.Lineup = nil
+	input.Invitations = nil
+	prepareEquipment(input, nil)
+
+	// The venue bookings are checked by the store under the same lock as the write.
+	createdEvent, err := d.store.CreateVenueEvent(ctx, input, func(venueEvents []*eventproto.Event) error {
+		return checkVenueBooking(input, venueEvents)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create event in the store layer")
+	}
+
+	return createdEvent, nil
+}
+
+// ReadEvent implements Controller interface.
+// Private events can only be read by their members.
+func (d *controller) ReadEvent(ctx context.Context, id string, viewerID string) (*eventproto.Event, error) {
+	event, err := d.store.ReadEvent(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+	}
+
+	if event.GetVisibility() == eventproto.Event_PRIVATE && !isMember(event, viewerID) {
+		return nil, ErrPrivateEvent
+	}
+
+	return event, nil
+}
+
+// ListEvents implements Controller interface.
+// Events are filtered by their start time if any bound of the time window is set.
+// Recurring events are expanded into their occurrences if both bounds are set.
+// Events are sorted by their start time in the requested order, events without a start time are listed last.
+// Unlisted and private events are only listed to their members, so the limit is applied after hiding them.
+func (d *controller) ListEvents(ctx context.Context, req *eventproto.ListEventsRequest) ([]*eventproto.Event, error) {
+	if req.GetLimit() < 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	descending := req.GetSortOrder() == eventproto.ListEventsRequest_START_TIME_DESC
+	limit := int(req.GetLimit())
+
+	if req.GetStartAfter() == nil && req.GetStartBefore() == nil {
+		events, err := d.store.ListEvents(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list events in the store layer")
+		}
+
+		sortByStartTime(events, descending)
+		return limitEvents(visibleEvents(events, req.GetViewerId()), limit), nil
+	}
+
+	after, before := timeWindow(req)
+	if req.GetStartAfter() == nil || req.GetStartBefore() == nil {
+		events, err := d.store.ListEventsStartingBetween(ctx, after, before, descending, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list events by start time in the store layer")
+		}
+
+		return limitEvents(visibleEvents(events, req.GetViewerId()), limit), nil
+	}
+
+	recurring, err := d.store.ListRecurringEvents(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list recurring events in the store layer")
+	}
+
+	events, err := d.store.ListEventsStartingBetween(ctx, after, before, descending, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events by start time in the store layer")
+	}
+
+	// Recurring events found by their first start time are replaced by their occurrences.
+	var result []*eventproto.Event
+	for _, event := range events {
+		if event.GetRecurrence() == nil {
+			result = append(result, event)
+		}
+	}
+
+	for _, event := range recurring {
+		result = append(result, expandOccurrences(event, after, before)...)
+	}
+
+	sortByStartTime(result, descending)
+	return limitEvents(visibleEvents(result, req.GetViewerId()), limit), nil
+}
+
+// UpdateEvent implements Controller interface.
+// Fields managed by other operations, like the attendees or the status, are kept from the stored event,
+// so an update never overwrites concurrent joins. Completed events can't be updated.
+// The type can only be changed to a type of the catalog, but events keep their type if it was deleted.
+// The same goes for the venue, and the event can't be moved to a time its venue is already booked.
+func (d *controller) UpdateEvent(ctx context.Context, id string, input *eventproto.Event) (*eventproto.Event, error) {
+	venueErr := d.applyVenue(ctx, input)
+	if venueErr != nil && venueErr != ErrUnknownVenue {
+		return nil, venueErr
+	}
+
+	if err := validateEvent(input); err != nil {
+		return nil, err
+	}
+
+	knownType := d.knownEventType(ctx, input)
+
+	var promoted []*eventproto.User
+	updatedEvent, err := d.store.ModifyVenueEvent(ctx, id, input.GetVenueId(), func(event *eventproto.Event, venueEvents []*eventproto.Event) error {
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		if !knownType && input.GetEventType() != event.GetEventType() {
+			return ErrUnknownEventType
+		}
+
+		if venueErr != nil && input.GetVenueId() != event.GetVenueId() {
+			return venueErr
+		}
+
+		preserveManagedFields(input, event)
+
+		event.Reset()
+		proto.Merge(event, input)
+
+		if err := checkVenueBooking(event, venueEvents); err != nil {
+			return err
+		}
+
+		// The capacity may have grown, so fill the free spots from the waitlist.
+		promoted = d.promoteWaitlisted(event)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update event in the store layer with ID '%s'", id)
+	}
+
+	d.publishPromotions(ctx, updatedEvent.GetId(), promoted)
+
+	return updatedEvent, nil
+}
+
+// DeleteEvent implements Controller interface.
+// The business logic of the event deletion operation can be implemented within this function.
+// For now, it's not implemented because this is just an example of an architecture.
+func (d *controller) DeleteEvent(ctx context.Context, id string) error {
+	// Call the store directly.
+	if err := d.store.DeleteEvent(ctx, id); err != nil {
+		return errors.Wrapf(err, "unable to delete event in the store layer with ID '%s'", id)
+	}
+
+	return nil
+}
+
+// validateEvent returns an error if the given event input breaks the business rules.
+func validateEvent(input *eventproto.Event) error {
+	if _, ok := eventproto.Event_Visibility_name[int32(input.GetVisibility())]; !ok {
+		return ErrInvalidVisibility
+	}
+
+	input.TimeZone = strings.TrimSpace(input.GetTimeZone())
+	if !validTimeZone(input.GetTimeZone()) {
+		return ErrInvalidTimeZone
+	}
+
+	if err := validateRecurrence(input); err != nil {
+		return err
+	}
+
+	if l := input.GetLatLong(); l != nil && !geo.Valid(l.GetLatitude(), l.GetLongitude()) {
+		return ErrInvalidLocation
+	}
+
+	if err := validateDecision(input); err != nil {
+		return err
+	}
+
+	if err := validateEquipment(input); err != nil {
+		return err
+	}
+
+	for _, skill := range input.GetPlayerSkills() {
+		if !validSkill(skill) {
+			return ErrInvalidSkill
+		}
+	}
+
+	return nil
+}
+
+// preserveManagedFields copies the fields which can't be changed by an update from the stored event to the input.
+// The claims of the equipment items which are kept are preserved too.
+func preserveManagedFields(input, stored *eventproto.Event) {
+	input.Id = stored.GetId()
+	input.CreatedAt = stored.GetCreatedAt()
+	input.Attendees = stored.GetAttendees()
+	input.AttendeeCount = attendeeCount(stored)
+	input.Waitlist = stored.GetWaitlist()
+	input.Exceptions = stored.GetExceptions()
+	input.OriginalStartTime = nil
+	input.Status = stored.GetStatus()
+	input.CancellationReason = stored.GetCancellationReason()
+	input.ConfirmedAt = stored.GetConfirmedAt()
+	input.Lineup = stored.GetLineup()
+	input.Invitations = stored.GetInvitations()
+	prepareEquipment(input, stored)
+}
+
+// sortByStartTime sorts the given events by their start time, events without a start time are sorted last.
+func sortByStartTime(events []*eventproto.Event, descending bool) {
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].GetStartTime(), events[j].GetStartTime()
+		if a == nil || b == nil {
+			return a != nil
+		}
+
+		if descending {
+			a, b = b, a
+		}
+
+		if a.GetSeconds() != b.GetSeconds() {
+			return a.GetSeconds() < b.GetSeconds()
+		}
+		return a.GetNanos() < b.GetNanos()
+	})
+}
+
+// limitEvents returns at most limit first events of the given ones, all of them if limit is zero.
+func limitEvents(events []*eventproto.Event, limit int) []*eventproto.Event {
+	if limit > 0 && len(events) > limit {
+		return events[:limit]
+	}
+
+	return events
+}
+
+// attendeeCount returns the number of attendees of the given event.
+func attendeeCount(event *eventproto.Event) *common.Int64 {
+	return &common.Int64{
+		Value: int64(len(event.GetAttendees())),
+	}
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// errDecisionNotDue is returned by the modifier of DecideEvents when the event changed since it was listed,
+// e.g. it was cancelled or its deadline was moved, so it's skipped.
+var errDecisionNotDue = errors.New("decision isn't due")
+
+// DecideEvents implements Controller interface.
+// An event is confirmed if it has at least its minimum number of attendees, and cancelled otherwise.
+// Each event is decided once, so the decisions are only published once even if the scheduler runs again.
+// The first failure doesn't stop the other decisions, it's returned after all of them.
+func (d *controller) DecideEvents(ctx context.Context, now time.Time) error {
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	var firstErr error
+	for _, event := range events {
+		if !decisionDue(event, now) {
+			continue
+		}
+
+		if err := d.decideEvent(ctx, event.GetId(), now); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// decideEvent confirms or cancels the event with the given ID, and publishes the outcome.
+func (d *controller) decideEvent(ctx context.Context, id string, now time.Time) error {
+	outcome := eventproto.EventDecided_CONFIRMED
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if !decisionDue(event, now) {
+			return errDecisionNotDue
+		}
+
+		min := event.GetMinAttendees().GetValue()
+		if count := int64(len(event.GetAttendees())); count < min {
+			outcome = eventproto.EventDecided_CANCELLED
+			event.Status = eventproto.Event_CANCELLED
+			event.CancellationReason = fmt.Sprintf("only %d of the %d required attendees joined by the decision deadline", count, min)
+			return nil
+		}
+
+		event.ConfirmedAt, _ = ptypes.TimestampProto(now)
+		return nil
+	})
+	if errors.Cause(err) == errDecisionNotDue {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unable to decide event in the store layer with ID '%s'", id)
+	}
+
+	if err := d.decisions.Publish(ctx, &eventproto.EventDecided{
+		Event:   event,
+		Outcome: outcome,
+	}); err != nil {
+		// The decision is already stored, so a failed publication is only logged.
+		d.log.WithError(err).Errorf("unable to publish decision %s of event '%s'", outcome, id)
+	}
+
+	return nil
+}
+
+// decisionDue returns true if the given event is published, undecided and its decision deadline isn't after now.
+func decisionDue(event *eventproto.Event, now time.Time) bool {
+	if event.GetStatus() != eventproto.Event_PUBLISHED || event.GetConfirmedAt() != nil || event.GetDecisionDeadline() == nil {
+		return false
+	}
+
+	deadline, err := ptypes.Timestamp(event.GetDecisionDeadline())
+	return err == nil && !deadline.After(now)
+}
+
+// validateDecision returns an error if the minimum number of attendees or the decision deadline
+// of the given event input break the business rules.
+func validateDecision(input *eventproto.Event) error {
+	if min := input.GetMinAttendees(); min != nil {
+		max := input.GetMaxAttendees().GetValue()
+		if min.GetValue() < 0 || max > 0 && min.GetValue() > max {
+			return ErrInvalidMinAttendees
+		}
+	}
+
+	if input.GetDecisionDeadline() == nil {
+		return nil
+	}
+
+	if input.GetRecurrence() != nil {
+		return ErrInvalidDecisionDeadline
+	}
+
+	deadline, err := ptypes.Timestamp(input.GetDecisionDeadline())
+	if err != nil {
+		return ErrInvalidDecisionDeadline
+	}
+
+	start, err := ptypes.Timestamp(input.GetStartTime())
+	if err != nil || deadline.After(start) {
+		return ErrInvalidDecisionDeadline
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// ClaimEquipment implements Controller interface.
+// Only attendees can claim items, and never more units than are still uncovered.
+// Claiming an item again replaces the previous claim of the user.
+func (d *controller) ClaimEquipment(ctx context.Context, id string, itemID string, user *eventproto.User, quantity int64) (*eventproto.Event, error) {
+	if user.GetId() == "" {
+		return nil, ErrUserRequired
+	}
+
+	if quantity < 0 {
+		return nil, ErrInvalidQuantity
+	} else if quantity == 0 {
+		quantity = 1
+	}
+
+	// Claim the item atomically, so concurrent claims can't cover it twice.
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		if userIndex(event.GetAttendees(), user.GetId()) < 0 {
+			return ErrNotAttending
+		}
+
+		item := equipmentItem(event, itemID)
+		if item == nil {
+			return ErrNoSuchEquipment
+		}
+
+		releaseClaim(item, user.GetId())
+		if quantity > uncovered(item) {
+			return ErrEquipmentCovered
+		}
+
+		item.Claims = append(item.Claims, &eventproto.EquipmentClaim{
+			User:     user,
+			Quantity: quantity,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to claim equipment of event in the store layer with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// ReleaseEquipment implements Controller interface.
+func (d *controller) ReleaseEquipment(ctx context.Context, id string, itemID string, userID string) (*eventproto.Event, error) {
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		item := equipmentItem(event, itemID)
+		if item == nil {
+			return ErrNoSuchEquipment
+		}
+
+		if !releaseClaim(item, userID) {
+			return ErrNotClaimed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to release equipment of event in the store layer with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// validateEquipment returns an error if an item of the equipment checklist of the given event is invalid.
+func validateEquipment(input *eventproto.Event) error {
+	for _, item := range input.GetEquipment() {
+		if item.GetName() == "" || item.GetQuantity() < 0 {
+			return ErrInvalidEquipment
+		}
+	}
+
+	return nil
+}
+
+// prepareEquipment gives IDs to the new items of the equipment checklist of the input,
+// and keeps the claims of the items of the stored event. The stored event is nil on creation.
+func prepareEquipment(input, stored *eventproto.Event) {
+	seen := make(map[string]bool, len(input.GetEquipment()))
+	for _, item := range input.GetEquipment() {
+		item.Claims = nil
+
+		if existing := equipmentItem(stored, item.GetId()); existing != nil && !seen[item.GetId()] {
+			item.Claims = existing.GetClaims()
+		} else {
+			item.Id = uuid.New()
+		}
+
+		seen[item.GetId()] = true
+	}
+}
+
+// releaseClaims releases all the equipment claims of the user with the given ID.
+func releaseClaims(event *eventproto.Event, userID string) {
+	for _, item := range event.GetEquipment() {
+		releaseClaim(item, userID)
+	}
+}
+
+// releaseClaim releases the claim of the user with the given ID on the given item.
+// Returns false if the user didn't claim the item.
+func releaseClaim(item *eventproto.EquipmentItem, userID string) bool {
+	for i, claim := range item.GetClaims() {
+		if claim.GetUser().GetId() == userID {
+			item.Claims = append(item.Claims[:i], item.Claims[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// equipmentItem returns the item of the equipment checklist with the given ID, or nil if there is none.
+func equipmentItem(event *eventproto.Event, itemID string) *eventproto.EquipmentItem {
+	for _, item := range event.GetEquipment() {
+		if itemID != "" && item.GetId() == itemID {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// uncovered returns the number of units of the given item nobody claimed.
+func uncovered(item *eventproto.EquipmentItem) int64 {
+	quantity := item.GetQuantity()
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	for _, claim := range item.GetClaims() {
+		quantity -= claim.GetQuantity()
+	}
+
+	return quantity
+}
@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// Errors returned by the controller when a request violates the business rules.
+var (
+	// ErrUserRequired is returned when an operation requires a user but none is given.
+	ErrUserRequired = errors.New("user is required")
+
+	// ErrNotAttending is returned when a user neither attends an event nor waits for it.
+	ErrNotAttending = errors.New("user doesn't attend the event")
+
+	// ErrInvalidRecurrence is returned when the recurrence rule of an event can't be expanded.
+	ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+	// ErrNotRecurring is returned when an occurrence operation is applied to a non-recurring event.
+	ErrNotRecurring = errors.New("event is not recurring")
+
+	// ErrNoSuchOccurrence is returned when a recurring event has no occurrence at the given time.
+	ErrNoSuchOccurrence = errors.New("event has no occurrence at the given time")
+
+	// ErrNotWaitlisted is returned when a user isn't on the waitlist of an event.
+	ErrNotWaitlisted = errors.New("user isn't on the waitlist of the event")
+
+	// ErrInvalidLocation is returned when a location is missing or its coordinates are out of range.
+	ErrInvalidLocation = errors.New("invalid location")
+
+	// ErrInvalidRadius is returned when a search radius isn't positive.
+	ErrInvalidRadius = errors.New("search radius must be positive")
+
+	// ErrInvalidLimit is returned when a listing limit is negative.
+	ErrInvalidLimit = errors.New("limit can't be negative")
+
+	// ErrInvalidTransition is returned when an event can't move from its current status to the requested one.
+	ErrInvalidTransition = errors.New("invalid event status transition")
+
+	// ErrEventCompleted is returned when a completed event is modified.
+	ErrEventCompleted = errors.New("event is completed")
+
+	// ErrEventNotPublished is returned when a user joins an event which isn't published.
+	ErrEventNotPublished = errors.New("event isn't published")
+
+	// ErrReasonRequired is returned when an event is cancelled without a reason.
+	ErrReasonRequired = errors.New("cancellation reason is required")
+
+	// ErrInvalidImport is returned when an imported file can't be read.
+	ErrInvalidImport = errors.New("invalid import file")
+
+	// ErrTooManyRecords is returned when an imported file contains too many records.
+	ErrTooManyRecords = errors.New("too many records to import")
+
+	// ErrNameRequired is returned when an imported record has no name.
+	ErrNameRequired = errors.New("name is required")
+
+	// ErrNotOrganizer is returned when an operation reserved to the organizer of an event is done by another user.
+	ErrNotOrganizer = errors.New("user isn't the organizer of the event")
+
+	// ErrCheckInProofRequired is returned when a check-in has neither a code nor an organizer.
+	ErrCheckInProofRequired = errors.New("check-in code or organizer is required")
+
+	// ErrInvalidCheckInCode is returned when a check-in code is forged, expired or given for another event.
+	ErrInvalidCheckInCode = errors.New("invalid or expired check-in code")
+
+	// ErrCheckInCodeUsed is returned when a check-in code was already used by another attendee.
+	ErrCheckInCodeUsed = errors.New("check-in code was already used")
+
+	// ErrInvalidEquipment is returned when an equipment item has no name or a negative quantity.
+	ErrInvalidEquipment = errors.New("equipment item needs a name and a non-negative quantity")
+
+	// ErrNoSuchEquipment is returned when an event has no equipment item with the given ID.
+	ErrNoSuchEquipment = errors.New("event has no such equipment item")
+
+	// ErrInvalidQuantity is returned when a claimed quantity is negative.
+	ErrInvalidQuantity = errors.New("quantity can't be negative")
+
+	// ErrEquipmentCovered is returned when a claim exceeds the uncovered quantity of an equipment item.
+	ErrEquipmentCovered = errors.New("not enough uncovered units of the equipment item")
+
+	// ErrNotClaimed is returned when a user releases an equipment item the user didn't claim.
+	ErrNotClaimed = errors.New("user didn't claim the equipment item")
+
+	// ErrTextRequired is returned when a comment has no text.
+	ErrTextRequired = errors.New("text is required")
+
+	// ErrCommentTooLong is returned when the text of a comment exceeds the maximum length.
+	ErrCommentTooLong = errors.New("comment is too long")
+
+	// ErrNotAuthor is returned when a user changes a comment the user isn't allowed to.
+	ErrNotAuthor = errors.New("user isn't allowed to change the comment")
+
+	// ErrInvalidPageToken is returned when a page token can't be decoded.
+	ErrInvalidPageToken = errors.New("invalid page token")
+
+	// ErrUnknownEventType is returned when the type of an event isn't in the event type catalog.
+	ErrUnknownEventType = errors.New("unknown event type")
+
+	// ErrInvalidEventType is returned when an event type has no valid ID or display name, or inconsistent defaults.
+	ErrInvalidEventType = errors.New("event type needs a lowercase slug ID, a display name and consistent defaults")
+
+	// ErrInvalidSkill is returned when a skill level is negative or not a finite number.
+	ErrInvalidSkill = errors.New("skill level must be a non-negative number")
+
+	// ErrInvalidTeamCount is returned when the attendees can't be split into the requested number of teams.
+	ErrInvalidTeamCount = errors.New("team count must be between 2 and the number of attendees")
+
+	// ErrUnsatisfiableTeams is returned when no split of the attendees respects the team constraints.
+	ErrUnsatisfiableTeams = errors.New("team constraints can't be satisfied")
+
+	// ErrEventNotOver is returned when the result of an event is recorded before its end.
+	ErrEventNotOver = errors.New("event isn't over yet")
+
+	// ErrInvalidResult is returned when a result has no scores, a team scored twice or negative values.
+	ErrInvalidResult = errors.New("result needs a non-negative score for every team and valid player stats")
+
+	// ErrResultConfirmed is returned when a result is recorded again after its confirmation window.
+	ErrResultConfirmed = errors.New("result is confirmed, it can only be corrected")
+
+	// ErrNoResult is returned when a result is corrected before it's recorded.
+	ErrNoResult = errors.New("event has no result")
+
+	// ErrCorrectionReasonRequired is returned when a result is corrected without a reason.
+	ErrCorrectionReasonRequired = errors.New("correction reason is required")
+
+	// ErrInvalidVenue is returned when a venue has no name, a negative capacity or an empty amenity.
+	ErrInvalidVenue = errors.New("venue needs a name, a non-negative capacity and non-empty amenities")
+
+	// ErrInvalidOpeningHours is returned when the opening hours of a venue aren't HH:MM ranges of a weekday.
+	ErrInvalidOpeningHours = errors.New("opening hours need a weekday and a closing time after the opening time formatted as HH:MM")
+
+	// ErrUnknownVenue is returned when the venue of an event doesn't exist.
+	ErrUnknownVenue = errors.New("unknown venue")
+
+	// ErrVenueTooSmall is returned when the maximum number of attendees of an event exceeds the capacity of its venue.
+	ErrVenueTooSmall = errors.New("maximum number of attendees exceeds the capacity of the venue")
+
+	// ErrVenueBooked is returned when an event overlaps another event at the same venue.
+	ErrVenueBooked = errors.New("venue is already booked at that time")
+
+	// ErrInvalidVisibility is returned when the visibility of an event is unknown.
+	ErrInvalidVisibility = errors.New("invalid visibility")
+
+	// ErrPrivateEvent is returned when a private event is read by a user who isn't one of its members.
+	ErrPrivateEvent = errors.New("event is private")
+
+	// ErrNotInvited is returned when a user joins a private event or answers an invitation without being invited.
+	ErrNotInvited = errors.New("user isn't invited to the event")
+
+	// ErrInvalidInviteToken is returned when an invite link token is malformed, expired or signed for another event.
+	ErrInvalidInviteToken = errors.New("invalid or expired invite link")
+
+	// ErrStillAttending is returned when a user declines an invitation to an event the user attends or waits for.
+	ErrStillAttending = errors.New("user attends the event, it must be left instead")
+
+	// ErrInvalidTimeZone is returned when the time zone of an event or a venue isn't an IANA time zone.
+	ErrInvalidTimeZone = errors.New("time zone must be an IANA time zone name, e.g. Europe/Paris")
+
+	// ErrStartTimeRequired is returned when an event is cloned or created from a template without a start time.
+	ErrStartTimeRequired = errors.New("start time is required")
+
+	// ErrInvalidTemplate is returned when an event template has no name or no owner.
+	ErrInvalidTemplate = errors.New("template needs a name and an owner")
+
+	// ErrNotTemplateOwner is returned when an event template is changed or used by another user than its owner.
+	ErrNotTemplateOwner = errors.New("user isn't the owner of the template")
+
+	// ErrInvalidMinAttendees is returned when the minimum number of attendees of an event is negative
+	// or exceeds its maximum number of attendees.
+	ErrInvalidMinAttendees = errors.New("minimum attendees can't be negative or exceed the maximum attendees")
+
+	// ErrInvalidDecisionDeadline is returned when the decision deadline of an event isn't before the start
+	// of a single event.
+	ErrInvalidDecisionDeadline = errors.New("decision deadline needs a single event starting after it")
+
+	// ErrQueryRequired is returned when a full-text search query has no word to search.
+	ErrQueryRequired = errors.New("search query needs at least one word")
+
+	// ErrInvalidLeague is returned when a league has no name, no organizer, or less than two teams with distinct names.
+	ErrInvalidLeague = errors.New("league needs a name, an organizer and at least two teams with distinct names")
+
+	// ErrNotLeagueOrganizer is returned when an operation reserved to the organizer of a league is done by another user.
+	ErrNotLeagueOrganizer = errors.New("user isn't the organizer of the league")
+
+	// ErrInvalidSeason is returned when a season has no name, or less than two distinct teams of its league.
+	ErrInvalidSeason = errors.New("season needs a name and at least two distinct teams of its league")
+
+	// ErrInvalidRoundInterval is returned when the number of days between two rounds of fixtures is negative.
+	ErrInvalidRoundInterval = errors.New("round interval can't be negative")
+
+	// ErrFixturesGenerated is returned when the fixtures of a season are generated twice.
+	ErrFixturesGenerated = errors.New("fixtures of the season are already generated")
+)
+
+// ScheduleConflictError is returned when a user joins an event overlapping other events of the user.
+type ScheduleConflictError struct {
+	// Entries are the clashing events on the timeline of the user.
+	Entries []*eventproto.ScheduleEntry
+}
+
+// Error implements error interface.
+func (e *ScheduleConflictError) Error() string {
+	return fmt.Sprintf("event overlaps %d other events of the user", len(e.Entries))
+}
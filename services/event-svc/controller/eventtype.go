@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/marboga/gametimehero/proto/common"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// eventTypeIDPattern matches the slugs used as event type IDs, e.g. beach-volleyball.
+var eventTypeIDPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// DefaultEventTypes returns the catalog of event types a new service starts with.
+func DefaultEventTypes() []*eventproto.EventType {
+	return []*eventproto.EventType{
+		{
+			Id:              "basketball",
+			DisplayName:     "Basketball",
+			MinPlayers:      6,
+			MaxPlayers:      10,
+			DefaultDuration: 60,
+			EquipmentTemplate: []*eventproto.EquipmentItem{
+				{Name: "Ball"},
+			},
+		},
+		{
+			Id:              "running",
+			DisplayName:     "Running",
+			MinPlayers:      2,
+			DefaultDuration: 60,
+		},
+		{
+			Id:              "soccer",
+			DisplayName:     "Soccer",
+			MinPlayers:      10,
+			MaxPlayers:      22,
+			DefaultDuration: 90,
+			EquipmentTemplate: []*eventproto.EquipmentItem{
+				{Name: "Ball"},
+				{Name: "Bibs", Quantity: 11},
+				{Name: "Cones", Quantity: 4},
+			},
+		},
+		{
+			Id:              "tennis",
+			DisplayName:     "Tennis",
+			MinPlayers:      2,
+			MaxPlayers:      4,
+			DefaultDuration: 60,
+			EquipmentTemplate: []*eventproto.EquipmentItem{
+				{Name: "Balls", Quantity: 3},
+			},
+		},
+		{
+			Id:              "volleyball",
+			DisplayName:     "Volleyball",
+			MinPlayers:      8,
+			MaxPlayers:      12,
+			DefaultDuration: 90,
+			EquipmentTemplate: []*eventproto.EquipmentItem{
+				{Name: "Ball"},
+				{Name: "Net"},
+			},
+		},
+	}
+}
+
+// CreateEventType implements Controller interface.
+func (d *controller) CreateEventType(ctx context.Context, input *eventproto.EventType) (*eventproto.EventType, error) {
+	if err := validateEventType(input); err != nil {
+		return nil, err
+	}
+
+	eventType, err := d.store.CreateEventType(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create event type in the store layer")
+	}
+
+	return eventType, nil
+}
+
+// ReadEventType implements Controller interface.
+func (d *controller) ReadEventType(ctx context.Context, id string) (*eventproto.EventType, error) {
+	eventType, err := d.store.ReadEventType(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event type in the store layer with ID '%s'", id)
+	}
+
+	return eventType, nil
+}
+
+// ListEventTypes implements Controller interface.
+func (d *controller) ListEventTypes(ctx context.Context) ([]*eventproto.EventType, error) {
+	eventTypes, err := d.store.ListEventTypes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list event types in the store layer")
+	}
+
+	return eventTypes, nil
+}
+
+// UpdateEventType implements Controller interface.
+// The defaults only apply to the events created after the update.
+func (d *controller) UpdateEventType(ctx context.Context, id string, input *eventproto.EventType) (*eventproto.EventType, error) {
+	input.Id = id
+	if err := validateEventType(input); err != nil {
+		return nil, err
+	}
+
+	eventType, err := d.store.UpdateEventType(ctx, id, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update event type in the store layer with ID '%s'", id)
+	}
+
+	return eventType, nil
+}
+
+// DeleteEventType implements Controller interface.
+// The existing events of the type keep it, but no new event can use it.
+func (d *controller) DeleteEventType(ctx context.Context, id string) error {
+	if err := d.store.DeleteEventType(ctx, id); err != nil {
+		return errors.Wrapf(err, "unable to delete event type in the store layer with ID '%s'", id)
+	}
+
+	return nil
+}
+
+// applyEventType checks the type of the given event input against the catalog,
+// and fills the unset fields of the input with the defaults of its type.
+func (d *controller) applyEventType(ctx context.Context, input *eventproto.Event) error {
+	input.EventType = strings.ToLower(strings.TrimSpace(input.GetEventType()))
+	if input.GetEventType() == "" {
+		return nil
+	}
+
+	eventType, err := d.store.ReadEventType(ctx, input.GetEventType())
+	if err != nil {
+		return ErrUnknownEventType
+	}
+
+	if input.GetIconUrl() == "" {
+		input.IconUrl = eventType.GetIconUrl()
+	}
+
+	if input.GetDuration() == nil && eventType.GetDefaultDuration() > 0 {
+		input.Duration = &common.Int64{
+			Value: eventType.GetDefaultDuration(),
+		}
+	}
+
+	if input.GetMaxAttendees() == nil && eventType.GetMaxPlayers() > 0 {
+		input.MaxAttendees = &common.Int64{
+			Value: eventType.GetMaxPlayers(),
+		}
+	}
+
+	if input.GetMinAttendees() == nil && input.GetDecisionDeadline() != nil && eventType.GetMinPlayers() > 0 {
+		input.MinAttendees = &common.Int64{
+			Value: eventType.GetMinPlayers(),
+		}
+	}
+
+	if len(input.GetEquipment()) == 0 {
+		for _, item := range eventType.GetEquipmentTemplate() {
+			input.Equipment = append(input.Equipment, proto.Clone(item).(*eventproto.EquipmentItem))
+		}
+	}
+
+	return nil
+}
+
+// knownEventType returns true if the type of the given event input is in the catalog.
+func (d *controller) knownEventType(ctx context.Context, input *eventproto.Event) bool {
+	input.EventType = strings.ToLower(strings.TrimSpace(input.GetEventType()))
+	if input.GetEventType() == "" {
+		return true
+	}
+
+	_, err := d.store.ReadEventType(ctx, input.GetEventType())
+	return err == nil
+}
+
+// validateEventType returns an error if the given event type input breaks the business rules.
+// The equipment template is stripped of the IDs and the claims, which belong to the events.
+func validateEventType(input *eventproto.EventType) error {
+	if !eventTypeIDPattern.MatchString(input.GetId()) || strings.TrimSpace(input.GetDisplayName()) == "" {
+		return ErrInvalidEventType
+	}
+
+	if input.GetMinPlayers() < 0 || input.GetMaxPlayers() < 0 || input.GetDefaultDuration() < 0 {
+		return ErrInvalidEventType
+	}
+
+	if input.GetMaxPlayers() > 0 && input.GetMinPlayers() > input.GetMaxPlayers() {
+		return ErrInvalidEventType
+	}
+
+	for _, item := range input.GetEquipmentTemplate() {
+		if item.GetName() == "" || item.GetQuantity() < 0 {
+			return ErrInvalidEquipment
+		}
+
+		item.Id = ""
+		item.Claims = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// ReadCalendarFeed implements Controller interface.
+// The users without a feed get one on their first read.
+func (d *controller) ReadCalendarFeed(ctx context.Context, userID string) (*eventproto.CalendarFeed, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	feed, err := d.store.ReadCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read calendar feed in the store layer of user with ID '%s'", userID)
+	}
+
+	return feed, nil
+}
+
+// RotateCalendarFeed implements Controller interface.
+func (d *controller) RotateCalendarFeed(ctx context.Context, userID string) (*eventproto.CalendarFeed, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	feed, err := d.store.RotateCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to rotate calendar feed in the store layer of user with ID '%s'", userID)
+	}
+
+	return feed, nil
+}
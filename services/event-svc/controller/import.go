@@ -0,0 +1,390 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/marboga/gametimehero/proto/common"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/ical"
+	"github.com/marboga/gametimehero/utils/recurrence"
+)
+
+// maxImportRecords limits the number of records of an imported file.
+const maxImportRecords = 1000
+
+// csvColumns contains the columns of imported CSV files. The header row is required, columns can be in any order
+// and only name is mandatory:
+// - name: the name of the event;
+// - event_type: the type of the event, e.g. soccer;
+// - start_time: the start time in the RFC 3339 format, e.g. 2021-02-01T19:00:00Z;
+// - duration: the duration in minutes;
+// - latitude, longitude: the location in degrees, both or none of them must be set;
+// - description, icon_url: the texts of the event;
+// - equipment: the checklist as items separated by semicolons with an optional quantity, e.g. ball;cones:8;
+// - max_attendees: the maximum number of attendees, zero means unlimited;
+// - time_zone: the IANA time zone, e.g. Europe/Paris.
+var csvColumns = []string{
+	"name", "event_type", "start_time", "duration", "latitude", "longitude",
+	"description", "equipment", "max_attendees", "icon_url", "time_zone",
+}
+
+// importRecord is a record of an imported file converted to an event.
+type importRecord struct {
+	event *eventproto.Event
+
+	// skipped is the reason why the record isn't imported, if any.
+	skipped string
+
+	// err is the reason why the record can't be converted, if any.
+	err error
+}
+
+// ImportEvents implements Controller interface.
+// The imported events are created as drafts, so they can be reviewed before being published.
+func (d *controller) ImportEvents(ctx context.Context, req *eventproto.ImportEventsRequest) (*eventproto.ImportReport, error) {
+	var records []*importRecord
+	var err error
+	switch req.GetFormat() {
+	case eventproto.ImportEventsRequest_CSV:
+		records, err = parseCSV(req.GetData())
+	case eventproto.ImportEventsRequest_ICALENDAR:
+		records, err = parseICalendar(req.GetData())
+	default:
+		err = errors.Errorf("unsupported format %s", req.GetFormat())
+	}
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidImport, err.Error())
+	}
+
+	if len(records) > maxImportRecords {
+		return nil, ErrTooManyRecords
+	}
+
+	// Index existing events to skip the records which were already imported.
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	existing := make(map[string]string, len(events))
+	for _, event := range events {
+		existing[importKey(event)] = event.GetId()
+	}
+
+	report := &eventproto.ImportReport{}
+	for i, record := range records {
+		row := &eventproto.ImportRow{
+			Number: int64(i + 1),
+		}
+		report.Rows = append(report.Rows, row)
+
+		switch {
+		case record.err != nil:
+			row.Result, row.Message = eventproto.ImportRow_FAILED, record.err.Error()
+		case record.skipped != "":
+			row.Result, row.Message = eventproto.ImportRow_SKIPPED, record.skipped
+		case record.event.GetName() == "":
+			row.Result, row.Message = eventproto.ImportRow_FAILED, ErrNameRequired.Error()
+		case existing[importKey(record.event)] != "":
+			row.Result, row.Message = eventproto.ImportRow_SKIPPED, "event already exists"
+			row.EventId = existing[importKey(record.event)]
+		default:
+			record.event.Creator = req.GetCreator()
+
+			event, err := d.CreateEvent(ctx, record.event)
+			if err != nil {
+				row.Result, row.Message = eventproto.ImportRow_FAILED, err.Error()
+				break
+			}
+
+			row.Result, row.EventId = eventproto.ImportRow_CREATED, event.GetId()
+			existing[importKey(event)] = event.GetId()
+		}
+
+		switch row.Result {
+		case eventproto.ImportRow_CREATED:
+			report.Created++
+		case eventproto.ImportRow_SKIPPED:
+			report.Skipped++
+		case eventproto.ImportRow_FAILED:
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// parseCSV converts the records of the given CSV file to events.
+// The malformed rows are returned as failed records, only an invalid header fails the whole file.
+func parseCSV(data []byte) ([]*importRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read header")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !hasColumn(name) {
+			return nil, errors.Errorf("unknown column '%s'", name)
+		}
+		columns[name] = i
+	}
+
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New("missing column 'name'")
+	}
+
+	var records []*importRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		// A malformed row fails on its own, the reader goes on with the next line.
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			records = append(records, &importRecord{
+				err: parseErr,
+			})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := csvEvent(columns, row)
+		records = append(records, &importRecord{
+			event: event,
+			err:   err,
+		})
+	}
+
+	return records, nil
+}
+
+// csvEvent converts a CSV row to an event.
+func csvEvent(columns map[string]int, row []string) (*eventproto.Event, error) {
+	value := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	event := &eventproto.Event{
+		Name:        value("name"),
+		EventType:   value("event_type"),
+		Description: value("description"),
+		IconUrl:     value("icon_url"),
+		TimeZone:    value("time_zone"),
+	}
+
+	if s := value("equipment"); s != "" {
+		for _, item := range strings.Split(s, ";") {
+			name, quantity := item, ""
+			if i := strings.LastIndex(item, ":"); i >= 0 {
+				name, quantity = item[:i], item[i+1:]
+			}
+
+			equipmentItem := &eventproto.EquipmentItem{
+				Name: strings.TrimSpace(name),
+			}
+			if quantity != "" {
+				n, err := strconv.ParseInt(strings.TrimSpace(quantity), 10, 64)
+				if err != nil {
+					return nil, errors.Errorf("invalid quantity of equipment '%s'", item)
+				}
+				equipmentItem.Quantity = n
+			}
+
+			if equipmentItem.Name != "" {
+				event.Equipment = append(event.Equipment, equipmentItem)
+			}
+		}
+	}
+
+	if s := value("start_time"); s != "" {
+		startTime, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, errors.Errorf("invalid start_time '%s'", s)
+		}
+		event.StartTime, _ = ptypes.TimestampProto(startTime)
+	}
+
+	for name, field := range map[string]**common.Int64{"duration": &event.Duration, "max_attendees": &event.MaxAttendees} {
+		if s := value(name); s != "" {
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil || n < 0 {
+				return nil, errors.Errorf("invalid %s '%s'", name, s)
+			}
+			*field = &common.Int64{Value: n}
+		}
+	}
+
+	if latitude, longitude := value("latitude"), value("longitude"); latitude != "" || longitude != "" {
+		lat, latErr := strconv.ParseFloat(latitude, 64)
+		long, longErr := strconv.ParseFloat(longitude, 64)
+		if latErr != nil || longErr != nil {
+			return nil, errors.Errorf("invalid location '%s, %s'", latitude, longitude)
+		}
+		event.LatLong = &eventproto.LatLong{
+			Latitude:  lat,
+			Longitude: long,
+		}
+	}
+
+	return event, nil
+}
+
+// parseICalendar converts the events of the given iCalendar file to events.
+// Modified occurrences of a recurring event are merged into its series as exceptions.
+func parseICalendar(data []byte) ([]*importRecord, error) {
+	calendar, err := ical.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*importRecord, len(calendar.Events))
+	series := make(map[string]int)
+	for i, e := range calendar.Events {
+		if e.Err != nil {
+			// An invalid event fails on its own, the other events are still imported.
+			records[i] = &importRecord{
+				err: e.Err,
+			}
+		} else if e.RecurrenceID.IsZero() {
+			event, err := icalEvent(e)
+			records[i] = &importRecord{
+				event: event,
+				err:   err,
+			}
+			if e.Status == ical.StatusCancelled {
+				records[i].skipped = "event is cancelled"
+			}
+			series[e.UID] = i
+		}
+	}
+
+	for i, e := range calendar.Events {
+		if e.Err == nil && !e.RecurrenceID.IsZero() {
+			records[i] = mergeOccurrence(records, series, e)
+		}
+	}
+
+	return records, nil
+}
+
+// icalEvent converts a calendar event to an event.
+func icalEvent(e ical.Event) (*eventproto.Event, error) {
+	event := &eventproto.Event{
+		Name:        e.Summary,
+		Description: e.Description,
+	}
+
+	if e.Location != "" && e.Geo == nil {
+		event.Description = strings.TrimSpace(fmt.Sprintf("%s\n\nLocation: %s", event.Description, e.Location))
+	}
+
+	if !e.Start.IsZero() {
+		event.StartTime, _ = ptypes.TimestampProto(e.Start)
+
+		// Date-times with a TZID are read in that time zone.
+		if zone := e.Start.Location().String(); zone != "UTC" {
+			event.TimeZone = zone
+		}
+	}
+
+	if e.End.After(e.Start) {
+		event.Duration = &common.Int64{Value: int64(e.End.Sub(e.Start) / time.Minute)}
+	}
+
+	if e.Geo != nil {
+		event.LatLong = &eventproto.LatLong{
+			Latitude:  e.Geo.Latitude,
+			Longitude: e.Geo.Longitude,
+		}
+	}
+
+	if e.RRule != "" {
+		rule, err := recurrence.ParseRule(e.RRule)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidRecurrence, err.Error())
+		}
+		event.Recurrence = fromRule(rule)
+	}
+
+	for _, exDate := range e.ExDates {
+		originalStartTime, _ := ptypes.TimestampProto(exDate)
+		event.Exceptions = append(event.Exceptions, &eventproto.OccurrenceException{
+			OriginalStartTime: originalStartTime,
+			Cancelled:         true,
+		})
+	}
+
+	return event, nil
+}
+
+// mergeOccurrence adds the given modified occurrence to its series as an exception.
+// Returns the record of the occurrence.
+func mergeOccurrence(records []*importRecord, series map[string]int, e ical.Event) *importRecord {
+	i, ok := series[e.UID]
+	if !ok || records[i].event.GetRecurrence() == nil {
+		return &importRecord{
+			err: errors.Errorf("no recurring event with UID '%s'", e.UID),
+		}
+	}
+
+	exception := &eventproto.OccurrenceException{
+		Description: e.Description,
+	}
+	exception.OriginalStartTime, _ = ptypes.TimestampProto(e.RecurrenceID)
+	if !e.Start.Equal(e.RecurrenceID) {
+		exception.StartTime, _ = ptypes.TimestampProto(e.Start)
+	}
+	if e.End.After(e.Start) {
+		exception.Duration = &common.Int64{Value: int64(e.End.Sub(e.Start) / time.Minute)}
+	}
+	if e.Geo != nil {
+		exception.LatLong = &eventproto.LatLong{
+			Latitude:  e.Geo.Latitude,
+			Longitude: e.Geo.Longitude,
+		}
+	}
+
+	records[i].event.Exceptions = append(records[i].event.Exceptions, exception)
+
+	return &importRecord{
+		skipped: fmt.Sprintf("merged into the recurring event of record %d", i+1),
+	}
+}
+
+// importKey identifies the imported records duplicating an event, i.e. with the same name and start time.
+func importKey(event *eventproto.Event) string {
+	return fmt.Sprintf("%s@%d", event.GetName(), event.GetStartTime().GetSeconds())
+}
+
+// hasColumn returns true if the given CSV column is supported.
+func hasColumn(name string) bool {
+	for _, column := range csvColumns {
+		if column == name {
+			return true
+		}
+	}
+
+	return false
+}
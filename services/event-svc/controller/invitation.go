@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/signature"
+)
+
+// inviteScope is signed together with the event ID and the expiry,
+// so the invite link tokens can't be used for anything else.
+const inviteScope = "invite"
+
+// InviteUsers implements Controller interface.
+// Inviting a user twice has no effect, but a user who declined is invited again.
+func (d *controller) InviteUsers(ctx context.Context, id string, organizerID string, userIDs []string) (*eventproto.Event, error) {
+	if len(userIDs) == 0 {
+		return nil, ErrUserRequired
+	}
+
+	for _, userID := range userIDs {
+		if strings.TrimSpace(userID) == "" {
+			return nil, ErrUserRequired
+		}
+	}
+
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if err := checkOrganizer(event, organizerID); err != nil {
+			return err
+		}
+
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		now := ptypes.TimestampNow()
+		for _, userID := range userIDs {
+			userID = strings.TrimSpace(userID)
+			if userID == organizerID {
+				continue
+			}
+
+			if i := invitationIndex(event, userID); i >= 0 {
+				if invitation := event.Invitations[i]; invitation.GetStatus() == eventproto.Invitation_DECLINED {
+					invitation.Status = eventproto.Invitation_PENDING
+					invitation.InvitedBy = organizerID
+					invitation.CreatedAt = now
+					invitation.RespondedAt = nil
+				}
+				continue
+			}
+
+			event.Invitations = append(event.Invitations, &eventproto.Invitation{
+				EventId:   event.GetId(),
+				UserId:    userID,
+				Status:    eventproto.Invitation_PENDING,
+				InvitedBy: organizerID,
+				CreatedAt: now,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to invite users in the store layer to event with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// CreateInviteLink implements Controller interface.
+// The token is the expiry followed by its signature the same way as the check-in codes, so nothing is stored.
+func (d *controller) CreateInviteLink(ctx context.Context, id string, organizerID string) (*eventproto.InviteLink, error) {
+	event, err := d.store.ReadEvent(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+	}
+
+	if err := checkOrganizer(event, organizerID); err != nil {
+		return nil, err
+	}
+
+	if err := checkEditable(event); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(d.inviteLinkTTL)
+	expiry := strconv.FormatInt(expiresAt.Unix(), 36)
+
+	link := &eventproto.InviteLink{
+		EventId: event.GetId(),
+		Token:   expiry + "." + signature.Sign(d.inviteSecret, inviteScope, event.GetId(), expiry),
+	}
+	link.ExpiresAt, _ = ptypes.TimestampProto(time.Unix(expiresAt.Unix(), 0))
+
+	return link, nil
+}
+
+// AcceptInvitation implements Controller interface.
+// The user either has an invitation or gives the token of a valid invite link, then joins the event like JoinEvent.
+func (d *controller) AcceptInvitation(ctx context.Context, id string, user *eventproto.User, token string, force bool) (*eventproto.Event, error) {
+	if token != "" && !d.validInviteToken(id, token) {
+		return nil, ErrInvalidInviteToken
+	}
+
+	return d.join(ctx, id, user, token != "", force)
+}
+
+// DeclineInvitation implements Controller interface.
+// The users attending the event or waiting for it have to leave it instead.
+func (d *controller) DeclineInvitation(ctx context.Context, id string, userID string) (*eventproto.Event, error) {
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		i := invitationIndex(event, userID)
+		if i < 0 {
+			return ErrNotInvited
+		}
+
+		if userIndex(event.GetAttendees(), userID) >= 0 || userIndex(event.GetWaitlist(), userID) >= 0 {
+			return ErrStillAttending
+		}
+
+		event.Invitations[i].Status = eventproto.Invitation_DECLINED
+		event.Invitations[i].RespondedAt = ptypes.TimestampNow()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decline invitation in the store layer to event with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// ListUserInvitations implements Controller interface.
+// Only the pending invitations to the events which can still be joined are listed, oldest first.
+func (d *controller) ListUserInvitations(ctx context.Context, userID string) ([]*eventproto.Invitation, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	var result []*eventproto.Invitation
+	for _, event := range events {
+		if event.GetStatus() == eventproto.Event_CANCELLED || event.GetStatus() == eventproto.Event_COMPLETED {
+			continue
+		}
+
+		if i := invitationIndex(event, userID); i >= 0 && event.Invitations[i].GetStatus() == eventproto.Invitation_PENDING {
+			result = append(result, event.Invitations[i])
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i].GetCreatedAt(), result[j].GetCreatedAt()
+		return a.GetSeconds() < b.GetSeconds() || a.GetSeconds() == b.GetSeconds() && a.GetNanos() < b.GetNanos()
+	})
+
+	return result, nil
+}
+
+// validInviteToken returns true if the given token was signed for the event with the given ID and isn't expired.
+func (d *controller) validInviteToken(eventID string, token string) bool {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[0], 36, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return false
+	}
+
+	return signature.Verify(d.inviteSecret, parts[1], inviteScope, eventID, parts[0])
+}
+
+// acceptInvitation marks the invitation of the user with the given ID to the given event as accepted.
+// A user who followed an invite link gets an accepted invitation if there is none.
+// Returns ErrNotInvited if the event is private and the user is neither invited nor its organizer.
+func acceptInvitation(event *eventproto.Event, userID string, linked bool) error {
+	now := ptypes.TimestampNow()
+	if i := invitationIndex(event, userID); i >= 0 {
+		event.Invitations[i].Status = eventproto.Invitation_ACCEPTED
+		event.Invitations[i].RespondedAt = now
+		return nil
+	}
+
+	if linked {
+		event.Invitations = append(event.Invitations, &eventproto.Invitation{
+			EventId:     event.GetId(),
+			UserId:      userID,
+			Status:      eventproto.Invitation_ACCEPTED,
+			InvitedBy:   event.GetCreator().GetId(),
+			CreatedAt:   now,
+			RespondedAt: now,
+		})
+		return nil
+	}
+
+	if event.GetVisibility() == eventproto.Event_PRIVATE && event.GetCreator().GetId() != userID {
+		return ErrNotInvited
+	}
+
+	return nil
+}
+
+// visibleTo returns true if the given event can be listed to the user with the given ID.
+// Public events are listed to everybody, unlisted and private ones only to their members.
+func visibleTo(event *eventproto.Event, userID string) bool {
+	return event.GetVisibility() == eventproto.Event_PUBLIC || isMember(event, userID)
+}
+
+// visibleEvents returns the given events which can be listed to the user with the given ID.
+func visibleEvents(events []*eventproto.Event, userID string) []*eventproto.Event {
+	var result []*eventproto.Event
+	for _, event := range events {
+		if visibleTo(event, userID) {
+			result = append(result, event)
+		}
+	}
+
+	return result
+}
+
+// isMember returns true if the user with the given ID organizes the given event, attends it, waits for it
+// or has an invitation which isn't declined.
+func isMember(event *eventproto.Event, userID string) bool {
+	if userID == "" {
+		return false
+	}
+
+	if event.GetCreator().GetId() == userID || userIndex(event.GetAttendees(), userID) >= 0 ||
+		userIndex(event.GetWaitlist(), userID) >= 0 {
+		return true
+	}
+
+	i := invitationIndex(event, userID)
+	return i >= 0 && event.Invitations[i].GetStatus() != eventproto.Invitation_DECLINED
+}
+
+// invitationIndex returns the index of the invitation of the user with the given ID, or -1 if there is none.
+func invitationIndex(event *eventproto.Event, userID string) int {
+	for i, invitation := range event.GetInvitations() {
+		if invitation.GetUserId() == userID {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,475 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/marboga/gametimehero/proto/common"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/roundrobin"
+)
+
+const (
+	// defaultRoundIntervalDays is the number of days between two rounds of fixtures if it's not given.
+	defaultRoundIntervalDays = 7
+
+	// winPoints and drawPoints are the points a team gets in the standings for a won and a drawn fixture.
+	winPoints  = 3
+	drawPoints = 1
+)
+
+// CreateLeague implements Controller interface.
+// The IDs of the teams are given by the store, the given ones are ignored.
+func (d *controller) CreateLeague(ctx context.Context, input *eventproto.League) (*eventproto.League, error) {
+	if err := validateLeague(input); err != nil {
+		return nil, err
+	}
+
+	if input.GetEventType() != "" {
+		if _, err := d.store.ReadEventType(ctx, input.GetEventType()); err != nil {
+			return nil, ErrUnknownEventType
+		}
+	}
+
+	league, err := d.store.CreateLeague(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create league in the store layer")
+	}
+
+	return league, nil
+}
+
+// ReadLeague implements Controller interface.
+func (d *controller) ReadLeague(ctx context.Context, id string) (*eventproto.League, error) {
+	league, err := d.store.ReadLeague(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read league in the store layer with ID '%s'", id)
+	}
+
+	return league, nil
+}
+
+// ListLeagues implements Controller interface.
+func (d *controller) ListLeagues(ctx context.Context) ([]*eventproto.League, error) {
+	leagues, err := d.store.ListLeagues(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list leagues in the store layer")
+	}
+
+	return leagues, nil
+}
+
+// CreateSeason implements Controller interface.
+// Only the organizer of the league can add a season, and the season starts without fixtures.
+func (d *controller) CreateSeason(ctx context.Context, input *eventproto.Season, organizerID string) (*eventproto.Season, error) {
+	league, err := d.store.ReadLeague(ctx, input.GetLeagueId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read league in the store layer with ID '%s'", input.GetLeagueId())
+	}
+
+	if err := checkLeagueOrganizer(league, organizerID); err != nil {
+		return nil, err
+	}
+
+	if err := validateSeason(league, input); err != nil {
+		return nil, err
+	}
+
+	input.Fixtures = nil
+
+	season, err := d.store.CreateSeason(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create season in the store layer")
+	}
+
+	return season, nil
+}
+
+// ReadSeason implements Controller interface.
+func (d *controller) ReadSeason(ctx context.Context, id string) (*eventproto.Season, error) {
+	season, err := d.store.ReadSeason(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read season in the store layer with ID '%s'", id)
+	}
+
+	return season, nil
+}
+
+// GenerateFixtures implements Controller interface.
+// The fixtures are created as published events of the organizer, the same way as by CreateEvent,
+// so the defaults of the type of the league and of the venues apply. The matches of a round are spread over
+// the venues, and the matches which don't fit are played one after another at the same venues.
+// Nothing is created if any fixture can't be, e.g. a venue is already booked.
+func (d *controller) GenerateFixtures(ctx context.Context, req *eventproto.GenerateFixturesRequest) (*eventproto.Season, error) {
+	if req.GetFirstRoundStart() == nil {
+		return nil, ErrStartTimeRequired
+	}
+
+	if req.GetRoundIntervalDays() < 0 {
+		return nil, ErrInvalidRoundInterval
+	}
+
+	timeZone := strings.TrimSpace(req.GetTimeZone())
+	if !validTimeZone(timeZone) {
+		return nil, ErrInvalidTimeZone
+	}
+
+	season, err := d.store.ReadSeason(ctx, req.GetSeasonId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read season in the store layer with ID '%s'", req.GetSeasonId())
+	}
+
+	if len(season.GetFixtures()) > 0 {
+		return nil, ErrFixturesGenerated
+	}
+
+	league, err := d.store.ReadLeague(ctx, season.GetLeagueId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read league in the store layer with ID '%s'", season.GetLeagueId())
+	}
+
+	if err := checkLeagueOrganizer(league, req.GetOrganizerId()); err != nil {
+		return nil, err
+	}
+
+	inputs, fixtures, err := d.planFixtures(ctx, league, season, req, timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every booking first, so a conflict doesn't leave a partial schedule behind.
+	// Each fixture is checked again when it's created, under the store lock.
+	for _, input := range inputs {
+		if err := d.applyVenue(ctx, input); err != nil {
+			return nil, err
+		}
+
+		venueEvents, err := d.store.ListVenueEvents(ctx, input.GetVenueId())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list events in the store layer of venue with ID '%s'", input.GetVenueId())
+		}
+
+		if err := checkVenueBooking(input, venueEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, input := range inputs {
+		event, err := d.createFixture(ctx, input)
+		if err != nil {
+			d.deleteFixtures(ctx, fixtures[:i])
+			return nil, err
+		}
+
+		fixtures[i].EventId = event.GetId()
+	}
+
+	season, err = d.store.ModifySeason(ctx, season.GetId(), func(season *eventproto.Season) error {
+		if len(season.GetFixtures()) > 0 {
+			return ErrFixturesGenerated
+		}
+
+		season.Fixtures = fixtures
+		return nil
+	})
+	if err != nil {
+		d.deleteFixtures(ctx, fixtures)
+		return nil, errors.Wrapf(err, "unable to store fixtures in the store layer of season with ID '%s'", req.GetSeasonId())
+	}
+
+	return season, nil
+}
+
+// ReadStandings implements Controller interface.
+// The standings are derived from the results of the fixtures each time they are read, so they are always live.
+// A fixture counts once its result scores both of its teams, named by their ID or their name,
+// and the cancelled fixtures don't count. Teams are ranked by points, goal difference, goals scored and name.
+func (d *controller) ReadStandings(ctx context.Context, seasonID string) ([]*eventproto.Standing, error) {
+	season, err := d.store.ReadSeason(ctx, seasonID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read season in the store layer with ID '%s'", seasonID)
+	}
+
+	league, err := d.store.ReadLeague(ctx, season.GetLeagueId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read league in the store layer with ID '%s'", season.GetLeagueId())
+	}
+
+	teams := seasonTeams(league, season)
+	rows := make(map[string]*eventproto.Standing, len(teams))
+	standings := make([]*eventproto.Standing, len(teams))
+	for i, team := range teams {
+		standings[i] = &eventproto.Standing{Team: team}
+		rows[team.GetId()] = standings[i]
+	}
+
+	eventIDs := make([]string, len(season.GetFixtures()))
+	for i, fixture := range season.GetFixtures() {
+		eventIDs[i] = fixture.GetEventId()
+	}
+
+	results, err := d.store.ListResults(ctx, eventIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list results in the store layer of season with ID '%s'", seasonID)
+	}
+
+	resultsByEvent := make(map[string]*eventproto.MatchResult, len(results))
+	for _, result := range results {
+		resultsByEvent[result.GetEventId()] = result
+	}
+
+	for _, fixture := range season.GetFixtures() {
+		result, ok := resultsByEvent[fixture.GetEventId()]
+		if !ok {
+			continue
+		}
+
+		home, away := rows[fixture.GetHomeTeamId()], rows[fixture.GetAwayTeamId()]
+		if home == nil || away == nil {
+			continue
+		}
+
+		homeScore, okHome := teamScore(result, home.GetTeam())
+		awayScore, okAway := teamScore(result, away.GetTeam())
+		if !okHome || !okAway {
+			continue
+		}
+
+		event, err := d.store.ReadEvent(ctx, fixture.GetEventId())
+		if err != nil || event.GetStatus() == eventproto.Event_CANCELLED {
+			continue
+		}
+
+		addMatch(home, homeScore, awayScore)
+		addMatch(away, awayScore, homeScore)
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		switch {
+		case a.GetPoints() != b.GetPoints():
+			return a.GetPoints() > b.GetPoints()
+		case a.GetGoalDifference() != b.GetGoalDifference():
+			return a.GetGoalDifference() > b.GetGoalDifference()
+		case a.GetGoalsFor() != b.GetGoalsFor():
+			return a.GetGoalsFor() > b.GetGoalsFor()
+		}
+		return a.GetTeam().GetName() < b.GetTeam().GetName()
+	})
+
+	for i, standing := range standings {
+		standing.Position = int64(i + 1)
+	}
+
+	return standings, nil
+}
+
+// planFixtures returns the event inputs of the fixtures of the given season and the fixtures themselves,
+// without their event IDs.
+func (d *controller) planFixtures(ctx context.Context, league *eventproto.League, season *eventproto.Season,
+	req *eventproto.GenerateFixturesRequest, timeZone string) ([]*eventproto.Event, []*eventproto.Fixture, error) {
+	location, _ := time.LoadLocation(timeZone)
+	firstRound, err := ptypes.Timestamp(req.GetFirstRoundStart())
+	if err != nil {
+		return nil, nil, ErrStartTimeRequired
+	}
+	firstRound = firstRound.In(location)
+
+	interval := int(req.GetRoundIntervalDays())
+	if interval == 0 {
+		interval = defaultRoundIntervalDays
+	}
+
+	duration := defaultBookingDuration
+	if eventType, err := d.store.ReadEventType(ctx, league.GetEventType()); err == nil && eventType.GetDefaultDuration() > 0 {
+		duration = time.Duration(eventType.GetDefaultDuration()) * time.Minute
+	}
+
+	var venueIDs []string
+	for _, venueID := range req.GetVenueIds() {
+		if venueID = strings.TrimSpace(venueID); venueID != "" {
+			venueIDs = append(venueIDs, venueID)
+		}
+	}
+
+	teams := seasonTeams(league, season)
+
+	var inputs []*eventproto.Event
+	var fixtures []*eventproto.Fixture
+	for r, round := range roundrobin.Rounds(len(teams), req.GetDoubleRoundRobin()) {
+		// The rounds keep the wall clock time of the first one in the time zone of the fixtures.
+		roundStart := firstRound.AddDate(0, 0, r*interval)
+
+		for i, match := range round {
+			home, away := teams[match.Home], teams[match.Away]
+
+			input := &eventproto.Event{
+				Name:        fmt.Sprintf("%s vs %s", home.GetName(), away.GetName()),
+				EventType:   league.GetEventType(),
+				Description: fmt.Sprintf("%s, %s, round %d", league.GetName(), season.GetName(), r+1),
+				TimeZone:    timeZone,
+				Duration:    &common.Int64{Value: int64(duration / time.Minute)},
+				Creator:     &eventproto.User{Id: req.GetOrganizerId()},
+			}
+
+			start := roundStart
+			if len(venueIDs) > 0 {
+				input.VenueId = venueIDs[i%len(venueIDs)]
+				start = start.Add(time.Duration(i/len(venueIDs)) * duration)
+			}
+			input.StartTime, _ = ptypes.TimestampProto(start)
+
+			inputs = append(inputs, input)
+			fixtures = append(fixtures, &eventproto.Fixture{
+				Round:      int64(r + 1),
+				HomeTeamId: home.GetId(),
+				AwayTeamId: away.GetId(),
+			})
+		}
+	}
+
+	return inputs, fixtures, nil
+}
+
+// createFixture creates and publishes the event of a fixture.
+func (d *controller) createFixture(ctx context.Context, input *eventproto.Event) (*eventproto.Event, error) {
+	event, err := d.CreateEvent(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.transition(ctx, event.GetId(), eventproto.Event_PUBLISHED, ""); err != nil {
+		d.deleteFixtures(ctx, []*eventproto.Fixture{{EventId: event.GetId()}})
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// deleteFixtures deletes the events of the given fixtures, after their generation failed.
+// The failures are only logged, the generation already failed.
+func (d *controller) deleteFixtures(ctx context.Context, fixtures []*eventproto.Fixture) {
+	for _, fixture := range fixtures {
+		if err := d.store.DeleteEvent(ctx, fixture.GetEventId()); err != nil {
+			d.log.WithError(err).Errorf("unable to delete fixture event '%s'", fixture.GetEventId())
+		}
+	}
+}
+
+// checkLeagueOrganizer returns ErrNotLeagueOrganizer if the user with the given ID doesn't organize the given league.
+func checkLeagueOrganizer(league *eventproto.League, organizerID string) error {
+	if organizerID == "" || league.GetOrganizerId() != organizerID {
+		return ErrNotLeagueOrganizer
+	}
+
+	return nil
+}
+
+// validateLeague returns an error if the given league input breaks the business rules.
+// A league needs at least two teams to play, and the teams are told apart by their names.
+func validateLeague(input *eventproto.League) error {
+	input.Name = strings.TrimSpace(input.GetName())
+	input.EventType = strings.ToLower(strings.TrimSpace(input.GetEventType()))
+	if input.GetName() == "" || input.GetOrganizerId() == "" || len(input.GetTeams()) < 2 {
+		return ErrInvalidLeague
+	}
+
+	names := make(map[string]bool, len(input.GetTeams()))
+	for _, team := range input.GetTeams() {
+		team.Name = strings.TrimSpace(team.GetName())
+		name := strings.ToLower(team.GetName())
+		if name == "" || names[name] {
+			return ErrInvalidLeague
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+// validateSeason returns an error if the given season input breaks the business rules.
+// The season takes all the teams of the league if none is given.
+func validateSeason(league *eventproto.League, input *eventproto.Season) error {
+	input.Name = strings.TrimSpace(input.GetName())
+	if input.GetName() == "" {
+		return ErrInvalidSeason
+	}
+
+	if len(input.GetTeamIds()) == 0 {
+		for _, team := range league.GetTeams() {
+			input.TeamIds = append(input.TeamIds, team.GetId())
+		}
+	}
+
+	known := make(map[string]bool, len(league.GetTeams()))
+	for _, team := range league.GetTeams() {
+		known[team.GetId()] = true
+	}
+
+	taken := make(map[string]bool, len(input.GetTeamIds()))
+	for _, teamID := range input.GetTeamIds() {
+		if !known[teamID] || taken[teamID] {
+			return ErrInvalidSeason
+		}
+		taken[teamID] = true
+	}
+
+	if len(taken) < 2 {
+		return ErrInvalidSeason
+	}
+
+	return nil
+}
+
+// seasonTeams returns the teams of the given league taking part in the given season, in the order of the season.
+func seasonTeams(league *eventproto.League, season *eventproto.Season) []*eventproto.LeagueTeam {
+	byID := make(map[string]*eventproto.LeagueTeam, len(league.GetTeams()))
+	for _, team := range league.GetTeams() {
+		byID[team.GetId()] = team
+	}
+
+	var teams []*eventproto.LeagueTeam
+	for _, teamID := range season.GetTeamIds() {
+		if team, ok := byID[teamID]; ok {
+			teams = append(teams, team)
+		}
+	}
+
+	return teams
+}
+
+// teamScore returns the score of the given team in the given result, which names the team by its ID or its name.
+func teamScore(result *eventproto.MatchResult, team *eventproto.LeagueTeam) (int64, bool) {
+	for _, score := range result.GetScores() {
+		if score.GetTeam() == team.GetId() || strings.EqualFold(score.GetTeam(), team.GetName()) {
+			return score.GetScore(), true
+		}
+	}
+
+	return 0, false
+}
+
+// addMatch adds a played fixture to the given standing.
+func addMatch(standing *eventproto.Standing, scored, conceded int64) {
+	standing.Played++
+	standing.GoalsFor += scored
+	standing.GoalsAgainst += conceded
+	standing.GoalDifference = standing.GetGoalsFor() - standing.GetGoalsAgainst()
+
+	switch {
+	case scored > conceded:
+		standing.Won++
+		standing.Points += winPoints
+	case scored == conceded:
+		standing.Drawn++
+		standing.Points += drawPoints
+	default:
+		standing.Lost++
+	}
+}
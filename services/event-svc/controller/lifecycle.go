@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// transitions contains the statuses every event status can move to.
+var transitions = map[eventproto.Event_Status][]eventproto.Event_Status{
+	eventproto.Event_DRAFT:     {eventproto.Event_PUBLISHED},
+	eventproto.Event_PUBLISHED: {eventproto.Event_CANCELLED, eventproto.Event_COMPLETED},
+}
+
+// PublishEvent implements Controller interface.
+func (d *controller) PublishEvent(ctx context.Context, id string) (*eventproto.Event, error) {
+	return d.transition(ctx, id, eventproto.Event_PUBLISHED, "")
+}
+
+// CancelEvent implements Controller interface.
+func (d *controller) CancelEvent(ctx context.Context, id string, reason string) (*eventproto.Event, error) {
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	return d.transition(ctx, id, eventproto.Event_CANCELLED, reason)
+}
+
+// CompleteEvent implements Controller interface.
+func (d *controller) CompleteEvent(ctx context.Context, id string) (*eventproto.Event, error) {
+	return d.transition(ctx, id, eventproto.Event_COMPLETED, "")
+}
+
+// transition moves an existing event found by its ID to the given status.
+func (d *controller) transition(ctx context.Context, id string, status eventproto.Event_Status, reason string) (*eventproto.Event, error) {
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if !canTransition(event.GetStatus(), status) {
+			return errors.Wrapf(ErrInvalidTransition, "event can't move from %s to %s", event.GetStatus(), status)
+		}
+
+		event.Status = status
+		event.CancellationReason = reason
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to change status of event in the store layer with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// canTransition reports whether an event can move from one status to another.
+func canTransition(from, to eventproto.Event_Status) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkEditable returns an error if the given event can't be modified anymore.
+func checkEditable(event *eventproto.Event) error {
+	if event.GetStatus() == eventproto.Event_COMPLETED {
+		return ErrEventCompleted
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/recurrence"
+)
+
+// ModifyOccurrence implements Controller interface.
+// An existing exception of the same occurrence is replaced.
+func (d *controller) ModifyOccurrence(ctx context.Context, id string, exception *eventproto.OccurrenceException) (*eventproto.Event, error) {
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		if event.GetRecurrence() == nil {
+			return ErrNotRecurring
+		}
+
+		originalStartTime, err := ptypes.Timestamp(exception.GetOriginalStartTime())
+		if err != nil {
+			return ErrNoSuchOccurrence
+		}
+
+		start, _ := ptypes.Timestamp(event.GetStartTime())
+		if !event.GetRecurrence().Rule().Includes(start.In(eventLocation(event)), originalStartTime) {
+			return ErrNoSuchOccurrence
+		}
+
+		for i, existing := range event.GetExceptions() {
+			if proto.Equal(existing.GetOriginalStartTime(), exception.GetOriginalStartTime()) {
+				event.Exceptions[i] = exception
+				return nil
+			}
+		}
+
+		event.Exceptions = append(event.Exceptions, exception)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to modify occurrence of event in the store layer with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// CancelOccurrence implements Controller interface.
+func (d *controller) CancelOccurrence(ctx context.Context, id string, originalStartTime *timestamp.Timestamp) (*eventproto.Event, error) {
+	return d.ModifyOccurrence(ctx, id, &eventproto.OccurrenceException{
+		OriginalStartTime: originalStartTime,
+		Cancelled:         true,
+	})
+}
+
+// validateRecurrence returns an error if the recurrence rule of the given event can't be expanded.
+func validateRecurrence(event *eventproto.Event) error {
+	if event.GetRecurrence() == nil {
+		return nil
+	}
+
+	if event.GetStartTime() == nil {
+		return errors.Wrap(ErrInvalidRecurrence, "start time is required")
+	}
+
+	if err := event.GetRecurrence().Rule().Validate(); err != nil {
+		return errors.Wrap(ErrInvalidRecurrence, err.Error())
+	}
+
+	return nil
+}
+
+// expandOccurrences returns the occurrences of the given recurring event which start within [after, before).
+// Occurrences are computed in the time zone of the event, so they keep the wall clock time of its start.
+// Cancelled occurrences are skipped and modified ones get the fields of their exception.
+func expandOccurrences(event *eventproto.Event, after, before time.Time) []*eventproto.Event {
+	start, err := ptypes.Timestamp(event.GetStartTime())
+	if err != nil {
+		return nil
+	}
+	start = start.In(eventLocation(event))
+
+	// Index exceptions by the original start time of their occurrences.
+	exceptions := make(map[int64]*eventproto.OccurrenceException, len(event.GetExceptions()))
+	for _, exception := range event.GetExceptions() {
+		if originalStartTime, err := ptypes.Timestamp(exception.GetOriginalStartTime()); err == nil {
+			exceptions[originalStartTime.UnixNano()] = exception
+		}
+	}
+
+	var occurrences []*eventproto.Event
+	for _, startTime := range event.GetRecurrence().Rule().Between(start, after, before) {
+		exception := exceptions[startTime.UnixNano()]
+		if exception.GetCancelled() {
+			continue
+		}
+
+		occurrence := proto.Clone(event).(*eventproto.Event)
+		occurrence.Exceptions = nil
+		occurrence.StartTime, _ = ptypes.TimestampProto(startTime)
+		occurrence.OriginalStartTime = occurrence.StartTime
+
+		if exception.GetStartTime() != nil {
+			occurrence.StartTime = exception.GetStartTime()
+		}
+		if exception.GetDuration() != nil {
+			occurrence.Duration = exception.GetDuration()
+		}
+		if exception.GetLatLong() != nil {
+			occurrence.LatLong = exception.GetLatLong()
+		}
+		if exception.GetDescription() != "" {
+			occurrence.Description = exception.GetDescription()
+		}
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences
+}
+
+// eventLocation returns the time zone of the given event, UTC if it isn't set or unknown.
+func eventLocation(event *eventproto.Event) *time.Location {
+	location, err := time.LoadLocation(event.GetTimeZone())
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// validTimeZone returns true if the given name is empty or the name of an IANA time zone.
+// The local time zone of the service is rejected, it isn't the same on every host.
+func validTimeZone(name string) bool {
+	if name == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// fromRule converts the recurrence rule to the recurrence proto model.
+func fromRule(rule recurrence.Rule) *eventproto.Recurrence {
+	r := &eventproto.Recurrence{
+		Frequency: eventproto.Recurrence_Frequency(rule.Frequency),
+		Interval:  int64(rule.Interval),
+		ByDay:     make([]eventproto.Weekday, len(rule.ByDay)),
+		Count:     int64(rule.Count),
+	}
+
+	for i, day := range rule.ByDay {
+		r.ByDay[i] = eventproto.Weekday(day)
+	}
+
+	if !rule.Until.IsZero() {
+		r.Until, _ = ptypes.TimestampProto(rule.Until)
+	}
+
+	return r
+}
+
+// timeWindow returns the bounds of the time window of the given request.
+// Unset bounds are replaced by the earliest and the latest supported times.
+func timeWindow(req *eventproto.ListEventsRequest) (time.Time, time.Time) {
+	after := time.Time{}
+	before := time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+
+	if req.GetStartAfter() != nil {
+		after, _ = ptypes.Timestamp(req.GetStartAfter())
+	}
+
+	if req.GetStartBefore() != nil {
+		before, _ = ptypes.Timestamp(req.GetStartBefore())
+	}
+
+	return after, before
+}
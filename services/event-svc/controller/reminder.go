@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// SendReminders implements Controller interface.
+// The reminders are computed from the current state of the events at every run, so the updated, rescheduled
+// and cancelled events are always reminded at the right time. Every reminder is recorded in the store
+// before it's published, so it's never sent twice by concurrent or later runs. The records only survive a restart
+// with a persistent store.Store implementation, the memory store loses them and may send them again.
+// When several reminders of an occurrence are due at once, e.g. after the service was down,
+// only the one closest to its start is sent.
+func (d *controller) SendReminders(ctx context.Context, now time.Time) error {
+	if len(d.reminderOffsets) == 0 {
+		return nil
+	}
+
+	// The records of the past occurrences aren't needed anymore.
+	if err := d.store.DeleteSentRemindersBefore(ctx, now); err != nil {
+		return errors.Wrap(err, "unable to delete sent reminders in the store layer")
+	}
+
+	offsets := make([]time.Duration, len(d.reminderOffsets))
+	copy(offsets, d.reminderOffsets)
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i] < offsets[j]
+	})
+
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	// Occurrences are assumed to be moved by less than a day, the same way as by occurrencesOf.
+	horizon := now.Add(offsets[len(offsets)-1] + 24*time.Hour)
+
+	var firstErr error
+	for _, event := range events {
+		if event.GetStatus() != eventproto.Event_PUBLISHED {
+			continue
+		}
+
+		for _, occurrence := range occurrencesOf(event, now, horizon) {
+			if err := d.remindOccurrence(ctx, occurrence, offsets, now); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// remindOccurrence sends the due reminder of the given upcoming occurrence, the offsets are sorted in ascending order.
+func (d *controller) remindOccurrence(ctx context.Context, occurrence *eventproto.Event, offsets []time.Duration, now time.Time) error {
+	start, err := ptypes.Timestamp(occurrence.GetStartTime())
+	if err != nil || !start.After(now) {
+		return nil
+	}
+
+	location := eventLocation(occurrence)
+
+	var due []time.Duration
+	for _, offset := range offsets {
+		if !reminderTime(start, offset, location).After(now) {
+			due = append(due, offset)
+		}
+	}
+
+	// The later reminders supersede the earlier ones, so only the first due one is sent.
+	for i, offset := range due {
+		sentAt, _ := ptypes.TimestampProto(now)
+		stored, err := d.store.CreateSentReminder(ctx, &eventproto.SentReminder{
+			EventId:       occurrence.GetId(),
+			StartTime:     occurrence.GetStartTime(),
+			OffsetMinutes: int64(offset / time.Minute),
+			SentAt:        sentAt,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to store reminder in the store layer of event with ID '%s'", occurrence.GetId())
+		}
+
+		if stored && i == 0 {
+			d.publishReminder(ctx, occurrence, offset)
+		}
+	}
+
+	return nil
+}
+
+// publishReminder announces the given occurrence to each of its attendees.
+// The reminder is already stored, so a failed publication is only logged.
+func (d *controller) publishReminder(ctx context.Context, occurrence *eventproto.Event, offset time.Duration) {
+	event := proto.Clone(occurrence).(*eventproto.Event)
+	event.Invitations = nil
+
+	for _, attendee := range event.GetAttendees() {
+		if err := d.reminders.Publish(ctx, &eventproto.EventReminder{
+			EventId:       event.GetId(),
+			User:          attendee,
+			Event:         event,
+			OffsetMinutes: int64(offset / time.Minute),
+		}); err != nil {
+			d.log.WithError(err).Errorf("unable to publish reminder of event '%s' to user '%s'", event.GetId(), attendee.GetId())
+		}
+	}
+}
+
+// reminderTime returns the time the reminder with the given offset of an occurrence starting at the given time is due.
+// The whole days of the offset keep the wall clock time in the given time zone, so the reminder a day before
+// an event starting at 19:00 is sent at 19:00 even across a DST change.
+func reminderTime(start time.Time, offset time.Duration, location *time.Location) time.Time {
+	days := int(offset / (24 * time.Hour))
+	return start.In(location).AddDate(0, 0, -days).Add(-(offset % (24 * time.Hour)))
+}
@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// RecordResult implements Controller interface.
+// Only the organizer can record the result of a published or completed event once it's over.
+// The result can be changed until the end of the confirmation window, which starts at the first recording.
+func (d *controller) RecordResult(ctx context.Context, req *eventproto.RecordResultRequest) (*eventproto.MatchResult, error) {
+	event, err := d.store.ReadEvent(ctx, req.GetEventId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", req.GetEventId())
+	}
+
+	now := time.Now()
+	if err := checkResultOpen(event, req.GetOrganizerId(), now); err != nil {
+		return nil, err
+	}
+
+	if err := validateResult(event, req.GetScores(), req.GetPlayerStats()); err != nil {
+		return nil, err
+	}
+
+	result, err := d.store.ModifyResult(ctx, event.GetId(), func(result *eventproto.MatchResult) error {
+		if result.GetRecordedAt() == nil {
+			result.RecordedAt, _ = ptypes.TimestampProto(now)
+			result.ConfirmedAt, _ = ptypes.TimestampProto(now.Add(d.resultConfirmationWindow))
+		} else if confirmed(result, now) {
+			return ErrResultConfirmed
+		}
+
+		result.RecordedBy = req.GetOrganizerId()
+		result.Scores = req.GetScores()
+		result.PlayerStats = req.GetPlayerStats()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to record result in the store layer of event with ID '%s'", event.GetId())
+	}
+
+	return result, nil
+}
+
+// ReadResult implements Controller interface.
+// The results of private events can only be read by their members.
+func (d *controller) ReadResult(ctx context.Context, eventID string, viewerID string) (*eventproto.MatchResult, error) {
+	if _, err := d.ReadEvent(ctx, eventID, viewerID); err != nil {
+		return nil, err
+	}
+
+	result, err := d.store.ReadResult(ctx, eventID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read result in the store layer of event with ID '%s'", eventID)
+	}
+
+	return result, nil
+}
+
+// CorrectResult implements Controller interface.
+// Only the organizer can correct a recorded result, and every correction is kept with the values it replaced.
+func (d *controller) CorrectResult(ctx context.Context, req *eventproto.CorrectResultRequest) (*eventproto.MatchResult, error) {
+	if strings.TrimSpace(req.GetReason()) == "" {
+		return nil, ErrCorrectionReasonRequired
+	}
+
+	event, err := d.store.ReadEvent(ctx, req.GetEventId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", req.GetEventId())
+	}
+
+	if err := checkOrganizer(event, req.GetOrganizerId()); err != nil {
+		return nil, err
+	}
+
+	if err := validateResult(event, req.GetScores(), req.GetPlayerStats()); err != nil {
+		return nil, err
+	}
+
+	result, err := d.store.ModifyResult(ctx, event.GetId(), func(result *eventproto.MatchResult) error {
+		if result.GetRecordedAt() == nil {
+			return ErrNoResult
+		}
+
+		correction := &eventproto.ResultCorrection{
+			CorrectedBy: req.GetOrganizerId(),
+			CorrectedAt: ptypes.TimestampNow(),
+			Reason:      strings.TrimSpace(req.GetReason()),
+		}
+		for _, score := range result.GetScores() {
+			correction.PreviousScores = append(correction.PreviousScores, proto.Clone(score).(*eventproto.TeamScore))
+		}
+		for _, stats := range result.GetPlayerStats() {
+			correction.PreviousPlayerStats = append(correction.PreviousPlayerStats, proto.Clone(stats).(*eventproto.PlayerStats))
+		}
+
+		result.Corrections = append(result.Corrections, correction)
+		result.Scores = req.GetScores()
+		result.PlayerStats = req.GetPlayerStats()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to correct result in the store layer of event with ID '%s'", event.GetId())
+	}
+
+	return result, nil
+}
+
+// checkResultOpen returns an error if the organizer with the given ID can't record the result of the given event.
+func checkResultOpen(event *eventproto.Event, organizerID string, now time.Time) error {
+	if err := checkOrganizer(event, organizerID); err != nil {
+		return err
+	}
+
+	switch event.GetStatus() {
+	case eventproto.Event_PUBLISHED, eventproto.Event_COMPLETED:
+	default:
+		return ErrEventNotPublished
+	}
+
+	if event.GetStartTime() == nil {
+		return ErrEventNotOver
+	}
+
+	start, _ := ptypes.Timestamp(event.GetStartTime())
+	end := start.Add(time.Duration(event.GetDuration().GetValue()) * time.Minute)
+	if now.Before(end) {
+		return ErrEventNotOver
+	}
+
+	return nil
+}
+
+// validateResult returns an error if the given scores and player statistics of the given event are invalid.
+// Every team is scored once, and only the attendees have statistics.
+func validateResult(event *eventproto.Event, scores []*eventproto.TeamScore, playerStats []*eventproto.PlayerStats) error {
+	if len(scores) == 0 {
+		return ErrInvalidResult
+	}
+
+	teams := make(map[string]bool, len(scores))
+	for _, score := range scores {
+		score.Team = strings.TrimSpace(score.GetTeam())
+		if score.GetTeam() == "" || score.GetScore() < 0 || teams[score.GetTeam()] {
+			return ErrInvalidResult
+		}
+		teams[score.GetTeam()] = true
+	}
+
+	players := make(map[string]bool, len(playerStats))
+	for _, stats := range playerStats {
+		userID := stats.GetUser().GetId()
+		if userID == "" || players[userID] {
+			return ErrInvalidResult
+		}
+		players[userID] = true
+
+		if userIndex(event.GetAttendees(), userID) < 0 {
+			return ErrNotAttending
+		}
+
+		for name, value := range stats.GetStats() {
+			if name == "" || value < 0 {
+				return ErrInvalidResult
+			}
+		}
+	}
+
+	return nil
+}
+
+// confirmed returns true if the confirmation window of the given result is over.
+func confirmed(result *eventproto.MatchResult, now time.Time) bool {
+	confirmedAt, _ := ptypes.Timestamp(result.GetConfirmedAt())
+	return !now.Before(confirmedAt)
+}
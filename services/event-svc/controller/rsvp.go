@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// JoinEvent implements Controller interface.
+// Only published events can be joined. Joining an event twice has no effect.
+// Once the event is full, the user is put on its waitlist.
+// Unless forced, a user can't join an event overlapping another event the user attends or created,
+// and a ScheduleConflictError lists the clashing events.
+// Private events can only be joined by the invited users, their invitation is accepted on the way.
+func (d *controller) JoinEvent(ctx context.Context, id string, user *eventproto.User, force bool) (*eventproto.Event, error) {
+	return d.join(ctx, id, user, false, force)
+}
+
+// join adds the given user to the attendees of the event with the given ID, see JoinEvent.
+// The user gets an accepted invitation if linked is true, i.e. the user followed a valid invite link.
+func (d *controller) join(ctx context.Context, id string, user *eventproto.User, linked, force bool) (*eventproto.Event, error) {
+	if user.GetId() == "" {
+		return nil, ErrUserRequired
+	}
+
+	if !force {
+		event, err := d.store.ReadEvent(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+		}
+
+		if err := d.checkSchedule(ctx, event, user.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Add the attendee atomically, so concurrent joins are not lost.
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if event.GetStatus() != eventproto.Event_PUBLISHED {
+			return ErrEventNotPublished
+		}
+
+		if err := acceptInvitation(event, user.GetId(), linked); err != nil {
+			return err
+		}
+
+		if userIndex(event.GetAttendees(), user.GetId()) >= 0 || userIndex(event.GetWaitlist(), user.GetId()) >= 0 {
+			return nil
+		}
+
+		if !d.hasFreeSpot(event) {
+			event.Waitlist = append(event.Waitlist, user)
+			return nil
+		}
+
+		event.Attendees = append(event.Attendees, user)
+		event.AttendeeCount = attendeeCount(event)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to join event in the store layer with ID '%s'", id)
+	}
+
+	return event, nil
+}
+
+// LeaveEvent implements Controller interface.
+// The spot of a leaving attendee is given to the first user on the waitlist, the equipment claimed
+// by the attendee is released and the attendee is removed from the teams. Completed events can't be left.
+func (d *controller) LeaveEvent(ctx context.Context, id string, userID string) (*eventproto.Event, error) {
+	// Remove the attendee atomically, so concurrent joins are not lost.
+	var promoted []*eventproto.User
+	event, err := d.store.ModifyEvent(ctx, id, func(event *eventproto.Event) error {
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		if i := userIndex(event.GetWaitlist(), userID); i >= 0 {
+			event.Waitlist = append(event.Waitlist[:i], event.Waitlist[i+1:]...)
+			return nil
+		}
+
+		i := userIndex(event.GetAttendees(), userID)
+		if i < 0 {
+			return ErrNotAttending
+		}
+
+		event.Attendees = append(event.Attendees[:i], event.Attendees[i+1:]...)
+		releaseClaims(event, userID)
+		removeFromLineup(event, userID)
+		promoted = d.promoteWaitlisted(event)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to leave event in the store layer with ID '%s'", id)
+	}
+
+	d.publishPromotions(ctx, event.GetId(), promoted)
+
+	return event, nil
+}
+
+// ReadWaitlistPosition implements Controller interface.
+func (d *controller) ReadWaitlistPosition(ctx context.Context, id string, userID string) (*eventproto.WaitlistPosition, error) {
+	event, err := d.store.ReadEvent(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+	}
+
+	i := userIndex(event.GetWaitlist(), userID)
+	if i < 0 {
+		return nil, ErrNotWaitlisted
+	}
+
+	return &eventproto.WaitlistPosition{
+		EventId:  event.GetId(),
+		UserId:   userID,
+		Position: int64(i + 1),
+	}, nil
+}
+
+// ListUserEvents implements Controller interface.
+// Events are ordered by their start time.
+func (d *controller) ListUserEvents(ctx context.Context, userID string) ([]*eventproto.Event, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	var result []*eventproto.Event
+	for _, event := range events {
+		if event.GetCreator().GetId() == userID || userIndex(event.GetAttendees(), userID) >= 0 {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+// capacityOf returns the maximum number of attendees of the given event, zero means unlimited.
+func (d *controller) capacityOf(event *eventproto.Event) int64 {
+	if event.GetMaxAttendees() != nil {
+		return event.GetMaxAttendees().GetValue()
+	}
+
+	return d.capacity
+}
+
+// hasFreeSpot returns true if one more user can attend the given event.
+func (d *controller) hasFreeSpot(event *eventproto.Event) bool {
+	capacity := d.capacityOf(event)
+	return capacity == 0 || int64(len(event.GetAttendees())) < capacity
+}
+
+// promoteWaitlisted moves users from the head of the waitlist to the attendees while there are free spots.
+// Returns the promoted users.
+func (d *controller) promoteWaitlisted(event *eventproto.Event) []*eventproto.User {
+	var promoted []*eventproto.User
+	for len(event.GetWaitlist()) > 0 && d.hasFreeSpot(event) {
+		user := event.Waitlist[0]
+		event.Waitlist = event.Waitlist[1:]
+		event.Attendees = append(event.Attendees, user)
+		promoted = append(promoted, user)
+	}
+
+	event.AttendeeCount = attendeeCount(event)
+	return promoted
+}
+
+// publishPromotions announces the given users promoted from the waitlist of an event.
+// The promotion is already stored, so a failed publication is only logged.
+func (d *controller) publishPromotions(ctx context.Context, eventID string, promoted []*eventproto.User) {
+	for _, user := range promoted {
+		if err := d.promotions.Publish(ctx, &eventproto.AttendeePromoted{
+			EventId: eventID,
+			User:    user,
+		}); err != nil {
+			d.log.WithError(err).Errorf("unable to publish promotion of user '%s' to event '%s'", user.GetId(), eventID)
+		}
+	}
+}
+
+// userIndex returns the position of the user with the given ID in the given list, or -1 if it's not there.
+func userIndex(users []*eventproto.User, userID string) int {
+	for i, user := range users {
+		if user.GetId() == userID {
+			return i
+		}
+	}
+
+	return -1
+}
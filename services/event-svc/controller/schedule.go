@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+const (
+	// bookingHorizon bounds the occurrences of a recurring event checked for conflicts.
+	bookingHorizon = 366 * 24 * time.Hour
+
+	// defaultBookingDuration is the time an event without a duration is assumed to last.
+	defaultBookingDuration = time.Hour
+
+	// defaultScheduleWindow is the length of the timeline of a user if its end isn't given.
+	defaultScheduleWindow = 90 * 24 * time.Hour
+)
+
+// booking is the time range an event or an occurrence takes.
+type booking struct {
+	start, end time.Time
+}
+
+// overlaps reports whether the bookings share any instant.
+func (b booking) overlaps(o booking) bool {
+	return b.start.Before(o.end) && o.start.Before(b.end)
+}
+
+// scheduled is an entry of the timeline of a user with its time range.
+type scheduled struct {
+	booking
+	entry *eventproto.ScheduleEntry
+}
+
+// ReadUserSchedule implements Controller interface.
+// The timeline starts now and lasts the default schedule window unless its bounds are given.
+// Recurring events are expanded into their occurrences and cancelled events are skipped.
+func (d *controller) ReadUserSchedule(ctx context.Context, userID string, startAfter, startBefore *timestamp.Timestamp) ([]*eventproto.ScheduleEntry, error) {
+	if userID == "" {
+		return nil, ErrUserRequired
+	}
+
+	after, err := ptypes.Timestamp(startAfter)
+	if startAfter == nil || err != nil {
+		after = time.Now()
+	}
+
+	before, err := ptypes.Timestamp(startBefore)
+	if startBefore == nil || err != nil {
+		before = after.Add(defaultScheduleWindow)
+	}
+
+	timeline, err := d.userSchedule(ctx, userID, after, before)
+	if err != nil {
+		return nil, err
+	}
+
+	// Flag the entries overlapping each other, the waitlisted ones don't take any time of the user.
+	entries := make([]*eventproto.ScheduleEntry, len(timeline))
+	for i, s := range timeline {
+		entries[i] = s.entry
+		if s.entry.GetRole() == eventproto.ScheduleEntry_WAITLISTED {
+			continue
+		}
+
+		for _, next := range timeline[i+1:] {
+			if !next.start.Before(s.end) {
+				break
+			}
+
+			if next.entry.GetRole() != eventproto.ScheduleEntry_WAITLISTED {
+				s.entry.Overlapping = true
+				next.entry.Overlapping = true
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// checkSchedule returns a ScheduleConflictError if the given event overlaps another event
+// the user with the given ID attends or created. Only the upcoming occurrences of recurring events are checked.
+func (d *controller) checkSchedule(ctx context.Context, event *eventproto.Event, userID string) error {
+	start, err := ptypes.Timestamp(event.GetStartTime())
+	if err != nil {
+		return nil
+	}
+
+	if now := time.Now(); event.GetRecurrence() != nil && now.After(start) {
+		start = now
+	}
+
+	requested := bookingsOf(event, start, start.Add(bookingHorizon))
+	if len(requested) == 0 {
+		return nil
+	}
+
+	after, before := span(requested)
+	timeline, err := d.userSchedule(ctx, userID, after, before)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []*eventproto.ScheduleEntry
+	for _, s := range timeline {
+		if s.entry.GetEvent().GetId() == event.GetId() || s.entry.GetRole() == eventproto.ScheduleEntry_WAITLISTED {
+			continue
+		}
+
+		for _, b := range requested {
+			if b.overlaps(s.booking) {
+				conflicts = append(conflicts, s.entry)
+				break
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return &ScheduleConflictError{Entries: conflicts}
+	}
+
+	return nil
+}
+
+// userSchedule returns the events and the occurrences the user with the given ID created, attends or waits for,
+// which overlap [after, before). Cancelled events are skipped and the entries are sorted by their start time.
+func (d *controller) userSchedule(ctx context.Context, userID string, after, before time.Time) ([]scheduled, error) {
+	events, err := d.store.ListEvents(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events in the store layer")
+	}
+
+	var timeline []scheduled
+	for _, event := range events {
+		if event.GetStatus() == eventproto.Event_CANCELLED {
+			continue
+		}
+
+		var role eventproto.ScheduleEntry_Role
+		switch {
+		case event.GetCreator().GetId() == userID:
+			role = eventproto.ScheduleEntry_ORGANIZER
+		case userIndex(event.GetAttendees(), userID) >= 0:
+			role = eventproto.ScheduleEntry_ATTENDEE
+		case userIndex(event.GetWaitlist(), userID) >= 0:
+			role = eventproto.ScheduleEntry_WAITLISTED
+		default:
+			continue
+		}
+
+		for _, occurrence := range occurrencesOf(event, after, before) {
+			b, ok := bookingOf(occurrence)
+			if !ok || !b.overlaps(booking{start: after, end: before}) {
+				continue
+			}
+
+			endTime, _ := ptypes.TimestampProto(b.end)
+			timeline = append(timeline, scheduled{
+				booking: b,
+				entry: &eventproto.ScheduleEntry{
+					Event:   occurrence,
+					Role:    role,
+					EndTime: endTime,
+				},
+			})
+		}
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].start.Before(timeline[j].start)
+	})
+
+	return timeline, nil
+}
+
+// bookingsOf returns the bookings of the given event which overlap [after, before).
+func bookingsOf(event *eventproto.Event, after, before time.Time) []booking {
+	window := booking{start: after, end: before}
+
+	var bookings []booking
+	for _, occurrence := range occurrencesOf(event, after, before) {
+		if b, ok := bookingOf(occurrence); ok && b.overlaps(window) {
+			bookings = append(bookings, b)
+		}
+	}
+
+	return bookings
+}
+
+// occurrencesOf returns the given event, or its occurrences which may overlap [after, before) if it's recurring.
+// Occurrences are assumed to last less than a day.
+func occurrencesOf(event *eventproto.Event, after, before time.Time) []*eventproto.Event {
+	if event.GetRecurrence() == nil {
+		return []*eventproto.Event{event}
+	}
+
+	return expandOccurrences(event, after.Add(-24*time.Hour), before)
+}
+
+// bookingOf returns the time range of the given event, false if it has no start time.
+// Events without a duration last the default booking duration.
+func bookingOf(event *eventproto.Event) (booking, bool) {
+	start, err := ptypes.Timestamp(event.GetStartTime())
+	if err != nil {
+		return booking{}, false
+	}
+
+	duration := time.Duration(event.GetDuration().GetValue()) * time.Minute
+	if duration <= 0 {
+		duration = defaultBookingDuration
+	}
+
+	return booking{start: start, end: start.Add(duration)}, true
+}
+
+// span returns the earliest start and the latest end of the given bookings.
+func span(bookings []booking) (time.Time, time.Time) {
+	after, before := bookings[0].start, bookings[0].end
+	for _, b := range bookings[1:] {
+		if b.start.Before(after) {
+			after = b.start
+		}
+		if b.end.After(before) {
+			before = b.end
+		}
+	}
+
+	return after, before
+}
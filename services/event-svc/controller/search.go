@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/geo"
+)
+
+// SearchEventsNear implements Controller interface.
+// The store finds the events using its location index, the controller only computes and sorts their distances.
+// Unlisted and private events are only found by their members.
+func (d *controller) SearchEventsNear(ctx context.Context, center *eventproto.LatLong, radiusKm float64, viewerID string) ([]*eventproto.EventDistance, error) {
+	if center == nil || !geo.Valid(center.GetLatitude(), center.GetLongitude()) {
+		return nil, ErrInvalidLocation
+	}
+
+	if radiusKm <= 0 {
+		return nil, ErrInvalidRadius
+	}
+
+	events, err := d.store.ListEventsNear(ctx, center, radiusKm)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list events near location in the store layer")
+	}
+
+	events = visibleEvents(events, viewerID)
+
+	result := make([]*eventproto.EventDistance, len(events))
+	for i, event := range events {
+		result[i] = &eventproto.EventDistance{
+			Event: event,
+			DistanceKm: geo.Distance(
+				center.GetLatitude(), center.GetLongitude(),
+				event.GetLatLong().GetLatitude(), event.GetLatLong().GetLongitude(),
+			),
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetDistanceKm() < result[j].GetDistanceKm()
+	})
+
+	return result, nil
+}
+
+// SearchEvents implements Controller interface.
+// The store finds and ranks the events using its full-text index, the controller only hides the events
+// the viewer can't see, so the limit is applied after hiding them.
+func (d *controller) SearchEvents(ctx context.Context, req *eventproto.SearchEventsRequest) ([]*eventproto.EventMatch, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, ErrQueryRequired
+	}
+
+	if req.GetLimit() < 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	matches, err := d.store.SearchEvents(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to search events in the store layer")
+	}
+
+	var result []*eventproto.EventMatch
+	for _, match := range matches {
+		if !visibleTo(match.GetEvent(), req.GetViewerId()) {
+			continue
+		}
+
+		result = append(result, match)
+		if int64(len(result)) == req.GetLimit() {
+			break
+		}
+	}
+
+	return result, nil
+}
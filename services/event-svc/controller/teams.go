@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/teams"
+)
+
+// GenerateTeams implements Controller interface.
+// The skill levels of the request are stored with the ones of the event, and the players without a skill level
+// get the average of the known ones. Only the organizer can generate the teams of an event which isn't completed.
+func (d *controller) GenerateTeams(ctx context.Context, req *eventproto.GenerateTeamsRequest) (*eventproto.Event, error) {
+	for _, skill := range req.GetSkills() {
+		if !validSkill(skill) {
+			return nil, ErrInvalidSkill
+		}
+	}
+
+	event, err := d.store.ModifyEvent(ctx, req.GetEventId(), func(event *eventproto.Event) error {
+		if err := checkOrganizer(event, req.GetOrganizerId()); err != nil {
+			return err
+		}
+
+		if err := checkEditable(event); err != nil {
+			return err
+		}
+
+		if len(req.GetSkills()) > 0 && event.PlayerSkills == nil {
+			event.PlayerSkills = make(map[string]float64, len(req.GetSkills()))
+		}
+		for userID, skill := range req.GetSkills() {
+			event.PlayerSkills[userID] = skill
+		}
+
+		lineup, err := generateLineup(event, int(req.GetTeamCount()), teams.Constraints{
+			Together: playerGroups(req.GetKeepTogether()),
+			Apart:    playerGroups(req.GetKeepApart()),
+		})
+		if err != nil {
+			return err
+		}
+
+		event.Lineup = lineup
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to generate teams of event in the store layer with ID '%s'", req.GetEventId())
+	}
+
+	return event, nil
+}
+
+// generateLineup splits the attendees of the given event into balanced teams.
+func generateLineup(event *eventproto.Event, teamCount int, constraints teams.Constraints) (*eventproto.Lineup, error) {
+	lineup := &eventproto.Lineup{
+		GeneratedAt:  ptypes.TimestampNow(),
+		DefaultSkill: defaultSkill(event),
+		Skills:       make(map[string]float64, len(event.GetAttendees())),
+	}
+
+	players := make([]teams.Player, len(event.GetAttendees()))
+	for i, attendee := range event.GetAttendees() {
+		players[i] = teams.Player{
+			ID:    attendee.GetId(),
+			Skill: skillOf(event, lineup, attendee.GetId()),
+		}
+		lineup.Skills[attendee.GetId()] = players[i].Skill
+	}
+
+	split, err := teams.Balance(players, teamCount, constraints)
+	switch err {
+	case nil:
+	case teams.ErrTeamCount:
+		return nil, ErrInvalidTeamCount
+	case teams.ErrUnknownPlayer:
+		return nil, ErrNotAttending
+	default:
+		return nil, ErrUnsatisfiableTeams
+	}
+
+	for i, teamPlayers := range split {
+		team := &eventproto.Team{
+			Name: "Team " + strconv.Itoa(i+1),
+		}
+
+		for _, player := range teamPlayers {
+			team.Players = append(team.Players, event.GetAttendees()[userIndex(event.GetAttendees(), player.ID)])
+			team.TotalSkill += player.Skill
+		}
+
+		lineup.Teams = append(lineup.Teams, team)
+	}
+
+	return lineup, nil
+}
+
+// removeFromLineup removes the user with the given ID from the teams of the given event.
+// The skill level the teams were balanced with is subtracted, so the totals stay the sums of the players.
+func removeFromLineup(event *eventproto.Event, userID string) {
+	lineup := event.GetLineup()
+	for _, team := range lineup.GetTeams() {
+		if i := userIndex(team.GetPlayers(), userID); i >= 0 {
+			team.Players = append(team.Players[:i], team.Players[i+1:]...)
+			team.TotalSkill -= lineup.GetSkills()[userID]
+			delete(lineup.Skills, userID)
+		}
+	}
+}
+
+// defaultSkill returns the average skill level of the attendees of the given event with a known one,
+// or one if nobody has a skill level.
+func defaultSkill(event *eventproto.Event) float64 {
+	var total float64
+	var count int
+	for _, attendee := range event.GetAttendees() {
+		if skill, ok := event.GetPlayerSkills()[attendee.GetId()]; ok {
+			total += skill
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 1
+	}
+
+	return total / float64(count)
+}
+
+// skillOf returns the skill level of the user with the given ID in the given lineup.
+func skillOf(event *eventproto.Event, lineup *eventproto.Lineup, userID string) float64 {
+	if skill, ok := event.GetPlayerSkills()[userID]; ok {
+		return skill
+	}
+
+	return lineup.GetDefaultSkill()
+}
+
+// validSkill returns true if the given skill level is a non-negative number.
+func validSkill(skill float64) bool {
+	return skill >= 0 && !math.IsInf(skill, 0)
+}
+
+// playerGroups converts the given player groups to lists of user IDs.
+func playerGroups(groups []*eventproto.PlayerGroup) [][]string {
+	result := make([][]string, len(groups))
+	for i, group := range groups {
+		result[i] = group.GetUserIds()
+	}
+
+	return result
+}
@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+
+	"github.com/marboga/gametimehero/proto/common"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CloneEvent implements Controller interface.
+// The copy is a draft of the same organizer, its recurrence, waitlist, claims, lineup and invitations aren't copied.
+func (d *controller) CloneEvent(ctx context.Context, id string, organizerID string, startTime *timestamp.Timestamp, keepAttendees bool) (*eventproto.Event, error) {
+	if startTime == nil {
+		return nil, ErrStartTimeRequired
+	}
+
+	event, err := d.store.ReadEvent(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", id)
+	}
+
+	if err := checkOrganizer(event, organizerID); err != nil {
+		return nil, err
+	}
+
+	input := eventConfig(event)
+	input.StartTime = startTime
+	input.Creator = proto.Clone(event.GetCreator()).(*eventproto.User)
+	input.DecisionDeadline = shiftDeadline(event, startTime)
+
+	if keepAttendees {
+		for _, attendee := range event.GetAttendees() {
+			input.Attendees = append(input.Attendees, proto.Clone(attendee).(*eventproto.User))
+		}
+	}
+
+	return d.CreateEvent(ctx, input)
+}
+
+// CreateTemplate implements Controller interface.
+// Only the organizer of an event can copy its configuration to a template.
+func (d *controller) CreateTemplate(ctx context.Context, input *eventproto.EventTemplate, eventID string) (*eventproto.EventTemplate, error) {
+	if eventID != "" {
+		event, err := d.store.ReadEvent(ctx, eventID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read event in the store layer with ID '%s'", eventID)
+		}
+
+		if err := checkOrganizer(event, input.GetOwnerId()); err != nil {
+			return nil, err
+		}
+
+		input.Event = event
+	}
+
+	if err := validateTemplate(input); err != nil {
+		return nil, err
+	}
+
+	template, err := d.store.CreateTemplate(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create template in the store layer")
+	}
+
+	return template, nil
+}
+
+// ReadTemplate implements Controller interface.
+func (d *controller) ReadTemplate(ctx context.Context, id string) (*eventproto.EventTemplate, error) {
+	template, err := d.store.ReadTemplate(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read template in the store layer with ID '%s'", id)
+	}
+
+	return template, nil
+}
+
+// ListTemplates implements Controller interface.
+func (d *controller) ListTemplates(ctx context.Context, ownerID string) ([]*eventproto.EventTemplate, error) {
+	if ownerID == "" {
+		return nil, ErrUserRequired
+	}
+
+	templates, err := d.store.ListTemplates(ctx, ownerID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list templates in the store layer of user with ID '%s'", ownerID)
+	}
+
+	return templates, nil
+}
+
+// UpdateTemplate implements Controller interface.
+// The owner of a template can't be changed, the input must name the current owner.
+func (d *controller) UpdateTemplate(ctx context.Context, id string, input *eventproto.EventTemplate) (*eventproto.EventTemplate, error) {
+	if err := validateTemplate(input); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.ownedTemplate(ctx, id, input.GetOwnerId()); err != nil {
+		return nil, err
+	}
+
+	template, err := d.store.UpdateTemplate(ctx, id, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update template in the store layer with ID '%s'", id)
+	}
+
+	return template, nil
+}
+
+// DeleteTemplate implements Controller interface.
+// The events created from the template are kept.
+func (d *controller) DeleteTemplate(ctx context.Context, id string, ownerID string) error {
+	if _, err := d.ownedTemplate(ctx, id, ownerID); err != nil {
+		return err
+	}
+
+	if err := d.store.DeleteTemplate(ctx, id); err != nil {
+		return errors.Wrapf(err, "unable to delete template in the store layer with ID '%s'", id)
+	}
+
+	return nil
+}
+
+// CreateEventFromTemplate implements Controller interface.
+// The event is created the same way as by CreateEvent, so the defaults of its type and venue apply.
+func (d *controller) CreateEventFromTemplate(ctx context.Context, id string, creator *eventproto.User, startTime *timestamp.Timestamp) (*eventproto.Event, error) {
+	if startTime == nil {
+		return nil, ErrStartTimeRequired
+	}
+
+	template, err := d.ownedTemplate(ctx, id, creator.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	input := eventConfig(template.GetEvent())
+	input.StartTime = startTime
+	input.Creator = creator
+
+	return d.CreateEvent(ctx, input)
+}
+
+// ownedTemplate reads the template with the given ID and returns ErrNotTemplateOwner
+// if the user with the given ID isn't its owner.
+func (d *controller) ownedTemplate(ctx context.Context, id string, ownerID string) (*eventproto.EventTemplate, error) {
+	template, err := d.store.ReadTemplate(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read template in the store layer with ID '%s'", id)
+	}
+
+	if ownerID == "" || template.GetOwnerId() != ownerID {
+		return nil, ErrNotTemplateOwner
+	}
+
+	return template, nil
+}
+
+// validateTemplate returns an error if the given template input breaks the business rules.
+// Only the configuration of its event is kept.
+func validateTemplate(input *eventproto.EventTemplate) error {
+	input.Name = strings.TrimSpace(input.GetName())
+	if input.GetName() == "" || input.GetOwnerId() == "" {
+		return ErrInvalidTemplate
+	}
+
+	input.Event = eventConfig(input.GetEvent())
+	return validateEvent(input.GetEvent())
+}
+
+// eventConfig returns a copy of the configuration of the given event, i.e. the fields an organizer enters.
+// The start time, the creator, the attendees, the recurrence, the decision deadline
+// and the fields managed by the service are left out.
+func eventConfig(event *eventproto.Event) *eventproto.Event {
+	config := &eventproto.Event{
+		Name:        event.GetName(),
+		EventType:   event.GetEventType(),
+		IconUrl:     event.GetIconUrl(),
+		Description: event.GetDescription(),
+		VenueId:     event.GetVenueId(),
+		Visibility:  event.GetVisibility(),
+		TimeZone:    event.GetTimeZone(),
+	}
+
+	if event.GetLatLong() != nil {
+		config.LatLong = proto.Clone(event.GetLatLong()).(*eventproto.LatLong)
+	}
+
+	if event.GetDuration() != nil {
+		config.Duration = proto.Clone(event.GetDuration()).(*common.Int64)
+	}
+
+	if event.GetMaxAttendees() != nil {
+		config.MaxAttendees = proto.Clone(event.GetMaxAttendees()).(*common.Int64)
+	}
+
+	if event.GetMinAttendees() != nil {
+		config.MinAttendees = proto.Clone(event.GetMinAttendees()).(*common.Int64)
+	}
+
+	for _, item := range event.GetEquipment() {
+		config.Equipment = append(config.Equipment, &eventproto.EquipmentItem{
+			Name:     item.GetName(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	if len(event.GetPlayerSkills()) > 0 {
+		config.PlayerSkills = make(map[string]float64, len(event.GetPlayerSkills()))
+		for userID, skill := range event.GetPlayerSkills() {
+			config.PlayerSkills[userID] = skill
+		}
+	}
+
+	return config
+}
+
+// shiftDeadline returns the decision deadline of the given event moved to the given start time,
+// so the copy is decided the same time before it starts. Returns nil if the event has no decision deadline.
+func shiftDeadline(event *eventproto.Event, startTime *timestamp.Timestamp) *timestamp.Timestamp {
+	deadline, err := ptypes.Timestamp(event.GetDecisionDeadline())
+	if event.GetDecisionDeadline() == nil || err != nil {
+		return nil
+	}
+
+	oldStart, err := ptypes.Timestamp(event.GetStartTime())
+	if err != nil {
+		return nil
+	}
+
+	newStart, err := ptypes.Timestamp(startTime)
+	if err != nil {
+		return nil
+	}
+
+	shifted, _ := ptypes.TimestampProto(newStart.Add(deadline.Sub(oldStart)))
+	return shifted
+}
@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/marboga/gametimehero/proto/common"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/geo"
+)
+
+// openingHoursLayout is the layout of the opening and closing times of the venues.
+const openingHoursLayout = "15:04"
+
+// CreateVenue implements Controller interface.
+func (d *controller) CreateVenue(ctx context.Context, input *eventproto.Venue) (*eventproto.Venue, error) {
+	if err := validateVenue(input); err != nil {
+		return nil, err
+	}
+
+	venue, err := d.store.CreateVenue(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create venue in the store layer")
+	}
+
+	return venue, nil
+}
+
+// ReadVenue implements Controller interface.
+func (d *controller) ReadVenue(ctx context.Context, id string) (*eventproto.Venue, error) {
+	venue, err := d.store.ReadVenue(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read venue in the store layer with ID '%s'", id)
+	}
+
+	return venue, nil
+}
+
+// ListVenues implements Controller interface.
+func (d *controller) ListVenues(ctx context.Context) ([]*eventproto.Venue, error) {
+	venues, err := d.store.ListVenues(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list venues in the store layer")
+	}
+
+	return venues, nil
+}
+
+// UpdateVenue implements Controller interface.
+// The existing events keep the location they got from the venue until they are updated.
+func (d *controller) UpdateVenue(ctx context.Context, id string, input *eventproto.Venue) (*eventproto.Venue, error) {
+	if err := validateVenue(input); err != nil {
+		return nil, err
+	}
+
+	venue, err := d.store.UpdateVenue(ctx, id, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update venue in the store layer with ID '%s'", id)
+	}
+
+	return venue, nil
+}
+
+// DeleteVenue implements Controller interface.
+// The existing events keep their venue, but no new event can use it.
+func (d *controller) DeleteVenue(ctx context.Context, id string) error {
+	if err := d.store.DeleteVenue(ctx, id); err != nil {
+		return errors.Wrapf(err, "unable to delete venue in the store layer with ID '%s'", id)
+	}
+
+	return nil
+}
+
+// applyVenue checks the venue of the given event input, and replaces the location and the time zone
+// of the input by the venue's ones. The capacity of the venue is the default maximum number of attendees, and a larger maximum is rejected.
+func (d *controller) applyVenue(ctx context.Context, input *eventproto.Event) error {
+	input.VenueId = strings.TrimSpace(input.GetVenueId())
+	if input.GetVenueId() == "" {
+		return nil
+	}
+
+	venue, err := d.store.ReadVenue(ctx, input.GetVenueId())
+	if err != nil {
+		return ErrUnknownVenue
+	}
+
+	if venue.GetLatLong() != nil {
+		input.LatLong = proto.Clone(venue.GetLatLong()).(*eventproto.LatLong)
+	}
+
+	if venue.GetTimeZone() != "" {
+		input.TimeZone = venue.GetTimeZone()
+	}
+
+	if capacity := venue.GetCapacity(); capacity > 0 {
+		if input.GetMaxAttendees() == nil {
+			input.MaxAttendees = &common.Int64{
+				Value: capacity,
+			}
+		} else if max := input.GetMaxAttendees().GetValue(); max == 0 || max > capacity {
+			return ErrVenueTooSmall
+		}
+	}
+
+	return nil
+}
+
+// checkVenueBooking returns ErrVenueBooked if the given event overlaps another one of the given events at its venue.
+// The event itself is skipped, so an updated event never conflicts with itself.
+// Cancelled events free their venue, and recurring events are checked occurrence by occurrence.
+func checkVenueBooking(input *eventproto.Event, events []*eventproto.Event) error {
+	if input.GetVenueId() == "" || input.GetStartTime() == nil {
+		return nil
+	}
+
+	start, err := ptypes.Timestamp(input.GetStartTime())
+	if err != nil {
+		return nil
+	}
+
+	requested := bookingsOf(input, start, start.Add(bookingHorizon))
+	if len(requested) == 0 {
+		return nil
+	}
+
+	// Only the bookings within the span of the requested ones can overlap them.
+	after, before := span(requested)
+
+	for _, event := range events {
+		if event.GetId() == input.GetId() || event.GetStatus() == eventproto.Event_CANCELLED {
+			continue
+		}
+
+		for _, existing := range bookingsOf(event, after, before) {
+			for _, b := range requested {
+				if b.overlaps(existing) {
+					return errors.Wrapf(ErrVenueBooked, "overlaps event with ID '%s'", event.GetId())
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateVenue returns an error if the given venue input breaks the business rules.
+func validateVenue(input *eventproto.Venue) error {
+	input.Name = strings.TrimSpace(input.GetName())
+	if input.GetName() == "" || input.GetCapacity() < 0 {
+		return ErrInvalidVenue
+	}
+
+	if l := input.GetLatLong(); l != nil && !geo.Valid(l.GetLatitude(), l.GetLongitude()) {
+		return ErrInvalidLocation
+	}
+
+	input.TimeZone = strings.TrimSpace(input.GetTimeZone())
+	if !validTimeZone(input.GetTimeZone()) {
+		return ErrInvalidTimeZone
+	}
+
+	for i, amenity := range input.GetAmenities() {
+		input.Amenities[i] = strings.TrimSpace(amenity)
+		if input.Amenities[i] == "" {
+			return ErrInvalidVenue
+		}
+	}
+
+	for _, hours := range input.GetOpeningHours() {
+		if _, ok := eventproto.Weekday_name[int32(hours.GetDay())]; !ok {
+			return ErrInvalidOpeningHours
+		}
+
+		opens, err := time.Parse(openingHoursLayout, hours.GetOpens())
+		if err != nil {
+			return ErrInvalidOpeningHours
+		}
+
+		closes, err := time.Parse(openingHoursLayout, hours.GetCloses())
+		if err != nil || !closes.After(opens) {
+			return ErrInvalidOpeningHours
+		}
+	}
+
+	return nil
+}
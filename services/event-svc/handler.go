@@ -71,7 +71,7 @@ func (h *Handler) CreateEvent(ctx context.Context, req *eventproto.CreateEventRe
 // Calls the service's method to read an existing event by the given ID.
 func (h *Handler) ReadEvent(ctx context.Context, req *eventproto.ReadEventRequest, resp *eventproto.ReadEventResponse) error {
 	// Read event.
-	event, err := h.service.ReadEvent(ctx, req.GetEventId())
+	event, err := h.service.ReadEvent(ctx, req.GetEventId(), req.GetViewerId())
 	if err != nil {
 		// Try to convert the given error to the proto status.
 		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
@@ -93,10 +93,10 @@ func (h *Handler) ReadEvent(ctx context.Context, req *eventproto.ReadEventReques
 }
 
 // ListEvents implements eventproto.EventServiceHandler interface.
-// Calls the service's method to list all events.
+// Calls the service's method to list events matching the request.
 func (h *Handler) ListEvents(ctx context.Context, req *eventproto.ListEventsRequest, resp *eventproto.ListEventsResponse) error {
-	// List all events.
-	events, err := h.service.ListEvents(ctx)
+	// List events.
+	events, err := h.service.ListEvents(ctx, req)
 	if err != nil {
 		// Try to convert the given error to the proto status.
 		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
@@ -168,6 +168,1497 @@ func (h *Handler) DeleteEvent(ctx context.Context, req *eventproto.DeleteEventRe
 	return nil
 }
 
+// ImportEvents implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create events from the given file.
+func (h *Handler) ImportEvents(ctx context.Context, req *eventproto.ImportEventsRequest, resp *eventproto.ImportEventsResponse) error {
+	// Import events.
+	report, err := h.service.ImportEvents(ctx, req)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ImportEventsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to import events")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ImportEventsResponse_Report{
+		Report: report,
+	}
+	return nil
+}
+
+// CloneEvent implements eventproto.EventServiceHandler interface.
+// Calls the service's method to copy an existing event to a new start time.
+func (h *Handler) CloneEvent(ctx context.Context, req *eventproto.CloneEventRequest, resp *eventproto.CloneEventResponse) error {
+	// Clone event by its ID.
+	event, err := h.service.CloneEvent(ctx, req.GetEventId(), req.GetOrganizerId(), req.GetStartTime(), req.GetKeepAttendees())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CloneEventResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to clone event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CloneEventResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// JoinEvent implements eventproto.EventServiceHandler interface.
+// Calls the service's method to add the given user to the attendees of an existing event.
+func (h *Handler) JoinEvent(ctx context.Context, req *eventproto.JoinEventRequest, resp *eventproto.JoinEventResponse) error {
+	// Join event by its ID.
+	event, err := h.service.JoinEvent(ctx, req.GetEventId(), req.GetUser(), req.GetForce())
+	if err != nil {
+		// Return the clashing events if the event overlaps the schedule of the user.
+		var conflict *controller.ScheduleConflictError
+		if errors.As(err, &conflict) {
+			resp.Result = &eventproto.JoinEventResponse_Conflict{
+				Conflict: &eventproto.ScheduleConflict{
+					Entries: conflict.Entries,
+				},
+			}
+			return nil
+		}
+
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.JoinEventResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to join event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.JoinEventResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// LeaveEvent implements eventproto.EventServiceHandler interface.
+// Calls the service's method to remove the given user from the attendees of an existing event.
+func (h *Handler) LeaveEvent(ctx context.Context, req *eventproto.LeaveEventRequest, resp *eventproto.LeaveEventResponse) error {
+	// Leave event by its ID.
+	event, err := h.service.LeaveEvent(ctx, req.GetEventId(), req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.LeaveEventResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to leave event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.LeaveEventResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// ReadWaitlistPosition implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read the waitlist position of the given user for an existing event.
+func (h *Handler) ReadWaitlistPosition(ctx context.Context, req *eventproto.ReadWaitlistPositionRequest, resp *eventproto.ReadWaitlistPositionResponse) error {
+	// Read waitlist position by the event ID.
+	position, err := h.service.ReadWaitlistPosition(ctx, req.GetEventId(), req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadWaitlistPositionResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read waitlist position for event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadWaitlistPositionResponse_Position{
+		Position: position,
+	}
+	return nil
+}
+
+// ListUserEvents implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list the events created or joined by the given user.
+func (h *Handler) ListUserEvents(ctx context.Context, req *eventproto.ListUserEventsRequest, resp *eventproto.ListUserEventsResponse) error {
+	// List events of the user by its ID.
+	events, err := h.service.ListUserEvents(ctx, req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListUserEventsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to list events of user with ID '%s'", req.GetUserId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListUserEventsResponse_Data{
+		Data: &eventproto.ListUserEventsResponseOK{
+			Events: events,
+		},
+	}
+	return nil
+}
+
+// ReadUserSchedule implements eventproto.EventServiceHandler interface.
+// Calls the service's method to merge the events of the given user into one timeline.
+func (h *Handler) ReadUserSchedule(ctx context.Context, req *eventproto.ReadUserScheduleRequest, resp *eventproto.ReadUserScheduleResponse) error {
+	// Read schedule of the user by its ID.
+	entries, err := h.service.ReadUserSchedule(ctx, req.GetUserId(), req.GetStartAfter(), req.GetStartBefore())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadUserScheduleResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read schedule of user with ID '%s'", req.GetUserId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadUserScheduleResponse_Data{
+		Data: &eventproto.ReadUserScheduleResponseOK{
+			Entries: entries,
+		},
+	}
+	return nil
+}
+
+// ReadCalendarFeed implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read the calendar feed of the given user.
+func (h *Handler) ReadCalendarFeed(ctx context.Context, req *eventproto.ReadCalendarFeedRequest, resp *eventproto.ReadCalendarFeedResponse) error {
+	// Read calendar feed of the user.
+	feed, err := h.service.ReadCalendarFeed(ctx, req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadCalendarFeedResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read calendar feed of user with ID '%s'", req.GetUserId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadCalendarFeedResponse_Feed{
+		Feed: feed,
+	}
+	return nil
+}
+
+// RotateCalendarFeed implements eventproto.EventServiceHandler interface.
+// Calls the service's method to revoke the calendar feed URLs of the given user.
+func (h *Handler) RotateCalendarFeed(ctx context.Context, req *eventproto.RotateCalendarFeedRequest, resp *eventproto.RotateCalendarFeedResponse) error {
+	// Rotate calendar feed of the user.
+	feed, err := h.service.RotateCalendarFeed(ctx, req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.RotateCalendarFeedResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to rotate calendar feed of user with ID '%s'", req.GetUserId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.RotateCalendarFeedResponse_Feed{
+		Feed: feed,
+	}
+	return nil
+}
+
+// ModifyOccurrence implements eventproto.EventServiceHandler interface.
+// Calls the service's method to cancel or modify a single occurrence of an existing recurring event.
+func (h *Handler) ModifyOccurrence(ctx context.Context, req *eventproto.ModifyOccurrenceRequest, resp *eventproto.ModifyOccurrenceResponse) error {
+	// Modify occurrence of the event by its ID.
+	event, err := h.service.ModifyOccurrence(ctx, req.GetEventId(), req.GetException())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ModifyOccurrenceResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to modify occurrence of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ModifyOccurrenceResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// CancelOccurrence implements eventproto.EventServiceHandler interface.
+// Calls the service's method to cancel a single occurrence of an existing recurring event.
+func (h *Handler) CancelOccurrence(ctx context.Context, req *eventproto.CancelOccurrenceRequest, resp *eventproto.CancelOccurrenceResponse) error {
+	// Cancel occurrence of the event by its ID.
+	event, err := h.service.CancelOccurrence(ctx, req.GetEventId(), req.GetOriginalStartTime())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CancelOccurrenceResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to cancel occurrence of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CancelOccurrenceResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// PublishEvent implements eventproto.EventServiceHandler interface.
+// Calls the service's method to publish an existing draft event.
+func (h *Handler) PublishEvent(ctx context.Context, req *eventproto.PublishEventRequest, resp *eventproto.PublishEventResponse) error {
+	// Publish event by its ID.
+	event, err := h.service.PublishEvent(ctx, req.GetEventId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.PublishEventResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to publish event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.PublishEventResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// CancelEvent implements eventproto.EventServiceHandler interface.
+// Calls the service's method to cancel an existing published event with the given reason.
+func (h *Handler) CancelEvent(ctx context.Context, req *eventproto.CancelEventRequest, resp *eventproto.CancelEventResponse) error {
+	// Cancel event by its ID.
+	event, err := h.service.CancelEvent(ctx, req.GetEventId(), req.GetReason())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CancelEventResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to cancel event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CancelEventResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// CompleteEvent implements eventproto.EventServiceHandler interface.
+// Calls the service's method to complete an existing published event.
+func (h *Handler) CompleteEvent(ctx context.Context, req *eventproto.CompleteEventRequest, resp *eventproto.CompleteEventResponse) error {
+	// Complete event by its ID.
+	event, err := h.service.CompleteEvent(ctx, req.GetEventId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CompleteEventResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to complete event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CompleteEventResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// ClaimEquipment implements eventproto.EventServiceHandler interface.
+// Calls the service's method to commit an attendee to bring an equipment item of an existing event.
+func (h *Handler) ClaimEquipment(ctx context.Context, req *eventproto.ClaimEquipmentRequest, resp *eventproto.ClaimEquipmentResponse) error {
+	// Claim equipment item of the event by their IDs.
+	event, err := h.service.ClaimEquipment(ctx, req.GetEventId(), req.GetItemId(), req.GetUser(), req.GetQuantity())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ClaimEquipmentResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to claim equipment item '%s' of event with ID '%s'", req.GetItemId(), req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ClaimEquipmentResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// ReleaseEquipment implements eventproto.EventServiceHandler interface.
+// Calls the service's method to release the claim of a user on an equipment item of an existing event.
+func (h *Handler) ReleaseEquipment(ctx context.Context, req *eventproto.ReleaseEquipmentRequest, resp *eventproto.ReleaseEquipmentResponse) error {
+	// Release equipment item of the event by their IDs.
+	event, err := h.service.ReleaseEquipment(ctx, req.GetEventId(), req.GetItemId(), req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReleaseEquipmentResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to release equipment item '%s' of event with ID '%s'", req.GetItemId(), req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReleaseEquipmentResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// InviteUsers implements eventproto.EventServiceHandler interface.
+// Calls the service's method to invite the given users to an existing event.
+func (h *Handler) InviteUsers(ctx context.Context, req *eventproto.InviteUsersRequest, resp *eventproto.InviteUsersResponse) error {
+	// Invite users to the event by its ID.
+	event, err := h.service.InviteUsers(ctx, req.GetEventId(), req.GetOrganizerId(), req.GetUserIds())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.InviteUsersResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to invite users to event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.InviteUsersResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// CreateInviteLink implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create an invite link of an existing event.
+func (h *Handler) CreateInviteLink(ctx context.Context, req *eventproto.CreateInviteLinkRequest, resp *eventproto.CreateInviteLinkResponse) error {
+	// Create invite link of the event by its ID.
+	link, err := h.service.CreateInviteLink(ctx, req.GetEventId(), req.GetOrganizerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateInviteLinkResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to create invite link of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateInviteLinkResponse_InviteLink{
+		InviteLink: link,
+	}
+	return nil
+}
+
+// AcceptInvitation implements eventproto.EventServiceHandler interface.
+// Calls the service's method to accept an invitation and join an existing event.
+func (h *Handler) AcceptInvitation(ctx context.Context, req *eventproto.AcceptInvitationRequest, resp *eventproto.AcceptInvitationResponse) error {
+	// Accept invitation to the event by its ID.
+	event, err := h.service.AcceptInvitation(ctx, req.GetEventId(), req.GetUser(), req.GetToken(), req.GetForce())
+	if err != nil {
+		// Return the clashing events if the event overlaps the schedule of the user.
+		var conflict *controller.ScheduleConflictError
+		if errors.As(err, &conflict) {
+			resp.Result = &eventproto.AcceptInvitationResponse_Conflict{
+				Conflict: &eventproto.ScheduleConflict{
+					Entries: conflict.Entries,
+				},
+			}
+			return nil
+		}
+
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.AcceptInvitationResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to accept invitation to event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.AcceptInvitationResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// DeclineInvitation implements eventproto.EventServiceHandler interface.
+// Calls the service's method to decline an invitation to an existing event.
+func (h *Handler) DeclineInvitation(ctx context.Context, req *eventproto.DeclineInvitationRequest, resp *eventproto.DeclineInvitationResponse) error {
+	// Decline invitation to the event by its ID.
+	event, err := h.service.DeclineInvitation(ctx, req.GetEventId(), req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.DeclineInvitationResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to decline invitation to event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.DeclineInvitationResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// ListUserInvitations implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list the pending invitations of the given user.
+func (h *Handler) ListUserInvitations(ctx context.Context, req *eventproto.ListUserInvitationsRequest, resp *eventproto.ListUserInvitationsResponse) error {
+	// List invitations of the user by its ID.
+	invitations, err := h.service.ListUserInvitations(ctx, req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListUserInvitationsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to list invitations of user with ID '%s'", req.GetUserId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListUserInvitationsResponse_Data{
+		Data: &eventproto.ListUserInvitationsResponseOK{
+			Invitations: invitations,
+		},
+	}
+	return nil
+}
+
+// GenerateTeams implements eventproto.EventServiceHandler interface.
+// Calls the service's method to split the attendees of an existing event into balanced teams.
+func (h *Handler) GenerateTeams(ctx context.Context, req *eventproto.GenerateTeamsRequest, resp *eventproto.GenerateTeamsResponse) error {
+	// Generate teams of the event by its ID.
+	event, err := h.service.GenerateTeams(ctx, req)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.GenerateTeamsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to generate teams of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.GenerateTeamsResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// RecordResult implements eventproto.EventServiceHandler interface.
+// Calls the service's method to record the result of an existing event.
+func (h *Handler) RecordResult(ctx context.Context, req *eventproto.RecordResultRequest, resp *eventproto.RecordResultResponse) error {
+	// Record result of the event by its ID.
+	result, err := h.service.RecordResult(ctx, req)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.RecordResultResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to record result of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.RecordResultResponse_MatchResult{
+		MatchResult: result,
+	}
+	return nil
+}
+
+// ReadResult implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read the result of an existing event.
+func (h *Handler) ReadResult(ctx context.Context, req *eventproto.ReadResultRequest, resp *eventproto.ReadResultResponse) error {
+	// Read result of the event by its ID.
+	result, err := h.service.ReadResult(ctx, req.GetEventId(), req.GetViewerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadResultResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read result of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadResultResponse_MatchResult{
+		MatchResult: result,
+	}
+	return nil
+}
+
+// CorrectResult implements eventproto.EventServiceHandler interface.
+// Calls the service's method to correct the confirmed result of an existing event.
+func (h *Handler) CorrectResult(ctx context.Context, req *eventproto.CorrectResultRequest, resp *eventproto.CorrectResultResponse) error {
+	// Correct result of the event by its ID.
+	result, err := h.service.CorrectResult(ctx, req)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CorrectResultResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to correct result of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CorrectResultResponse_MatchResult{
+		MatchResult: result,
+	}
+	return nil
+}
+
+// CreateCheckInCode implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create a check-in code for an existing event.
+func (h *Handler) CreateCheckInCode(ctx context.Context, req *eventproto.CreateCheckInCodeRequest, resp *eventproto.CreateCheckInCodeResponse) error {
+	// Create check-in code of the event by its ID.
+	code, err := h.service.CreateCheckInCode(ctx, req.GetEventId(), req.GetOrganizerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateCheckInCodeResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to create check-in code for event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateCheckInCodeResponse_Code{
+		Code: code,
+	}
+	return nil
+}
+
+// CheckIn implements eventproto.EventServiceHandler interface.
+// Calls the service's method to record that the given user showed up at an existing event.
+func (h *Handler) CheckIn(ctx context.Context, req *eventproto.CheckInRequest, resp *eventproto.CheckInResponse) error {
+	// Check in the event by its ID.
+	attendance, err := h.service.CheckIn(ctx, req.GetEventId(), req.GetUser(), req.GetCode(), req.GetOrganizerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CheckInResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to check in event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CheckInResponse_Attendance{
+		Attendance: attendance,
+	}
+	return nil
+}
+
+// ListAttendance implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list who showed up at an existing event.
+func (h *Handler) ListAttendance(ctx context.Context, req *eventproto.ListAttendanceRequest, resp *eventproto.ListAttendanceResponse) error {
+	// List attendance of the event by its ID.
+	report, err := h.service.ListAttendance(ctx, req.GetEventId(), req.GetViewerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListAttendanceResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to list attendance of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListAttendanceResponse_Report{
+		Report: report,
+	}
+	return nil
+}
+
+// ReadUserAttendance implements eventproto.EventServiceHandler interface.
+// Calls the service's method to count the events the given user showed up at.
+func (h *Handler) ReadUserAttendance(ctx context.Context, req *eventproto.ReadUserAttendanceRequest, resp *eventproto.ReadUserAttendanceResponse) error {
+	// Read attendance of the user by its ID.
+	attendance, err := h.service.ReadUserAttendance(ctx, req.GetUserId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadUserAttendanceResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read attendance of user with ID '%s'", req.GetUserId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadUserAttendanceResponse_Attendance{
+		Attendance: attendance,
+	}
+	return nil
+}
+
+// SearchEventsNear implements eventproto.EventServiceHandler interface.
+// Calls the service's method to search events near the given location.
+func (h *Handler) SearchEventsNear(ctx context.Context, req *eventproto.SearchEventsNearRequest, resp *eventproto.SearchEventsNearResponse) error {
+	// Search events near the location.
+	events, err := h.service.SearchEventsNear(ctx, req.GetCenter(), req.GetRadiusKm(), req.GetViewerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.SearchEventsNearResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to search events near location")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.SearchEventsNearResponse_Data{
+		Data: &eventproto.SearchEventsNearResponseOK{
+			Events: events,
+		},
+	}
+	return nil
+}
+
+// SearchEvents implements eventproto.EventServiceHandler interface.
+// Calls the service's method to search events by the words of their name, description and type.
+func (h *Handler) SearchEvents(ctx context.Context, req *eventproto.SearchEventsRequest, resp *eventproto.SearchEventsResponse) error {
+	// Search events matching the query.
+	events, err := h.service.SearchEvents(ctx, req)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.SearchEventsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to search events")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.SearchEventsResponse_Data{
+		Data: &eventproto.SearchEventsResponseOK{
+			Events: events,
+		},
+	}
+	return nil
+}
+
+// CreateEventType implements eventproto.EventServiceHandler interface.
+// Calls the service's method to add a new event type to the catalog.
+func (h *Handler) CreateEventType(ctx context.Context, req *eventproto.CreateEventTypeRequest, resp *eventproto.CreateEventTypeResponse) error {
+	// Create event type.
+	eventType, err := h.service.CreateEventType(ctx, req.GetEventType())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateEventTypeResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to create event type")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateEventTypeResponse_EventType{
+		EventType: eventType,
+	}
+	return nil
+}
+
+// ReadEventType implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read an existing event type by the given ID.
+func (h *Handler) ReadEventType(ctx context.Context, req *eventproto.ReadEventTypeRequest, resp *eventproto.ReadEventTypeResponse) error {
+	// Read event type by its ID.
+	eventType, err := h.service.ReadEventType(ctx, req.GetEventTypeId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadEventTypeResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read event type with ID '%s'", req.GetEventTypeId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadEventTypeResponse_EventType{
+		EventType: eventType,
+	}
+	return nil
+}
+
+// ListEventTypes implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list the event type catalog.
+func (h *Handler) ListEventTypes(ctx context.Context, req *eventproto.ListEventTypesRequest, resp *eventproto.ListEventTypesResponse) error {
+	// List event types.
+	eventTypes, err := h.service.ListEventTypes(ctx)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListEventTypesResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to list event types")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListEventTypesResponse_Data{
+		Data: &eventproto.ListEventTypesResponseOK{
+			EventTypes: eventTypes,
+		},
+	}
+	return nil
+}
+
+// UpdateEventType implements eventproto.EventServiceHandler interface.
+// Calls the service's method to update an existing event type by the given ID and input.
+func (h *Handler) UpdateEventType(ctx context.Context, req *eventproto.UpdateEventTypeRequest, resp *eventproto.UpdateEventTypeResponse) error {
+	// Update event type by its ID.
+	eventType, err := h.service.UpdateEventType(ctx, req.GetEventTypeId(), req.GetEventType())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.UpdateEventTypeResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to update event type with ID '%s'", req.GetEventTypeId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.UpdateEventTypeResponse_EventType{
+		EventType: eventType,
+	}
+	return nil
+}
+
+// DeleteEventType implements eventproto.EventServiceHandler interface.
+// Calls the service's method to delete an existing event type by the given ID.
+func (h *Handler) DeleteEventType(ctx context.Context, req *eventproto.DeleteEventTypeRequest, resp *eventproto.DeleteEventTypeResponse) error {
+	// Delete event type by its ID.
+	if err := h.service.DeleteEventType(ctx, req.GetEventTypeId()); err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.DeleteEventTypeResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to delete event type with ID '%s'", req.GetEventTypeId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.DeleteEventTypeResponse_Empty{
+		Empty: &empty.Empty{},
+	}
+	return nil
+}
+
+// CreateVenue implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create a new venue.
+func (h *Handler) CreateVenue(ctx context.Context, req *eventproto.CreateVenueRequest, resp *eventproto.CreateVenueResponse) error {
+	// Create venue.
+	venue, err := h.service.CreateVenue(ctx, req.GetVenue())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateVenueResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to create venue")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateVenueResponse_Venue{
+		Venue: venue,
+	}
+	return nil
+}
+
+// ReadVenue implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read an existing venue by the given ID.
+func (h *Handler) ReadVenue(ctx context.Context, req *eventproto.ReadVenueRequest, resp *eventproto.ReadVenueResponse) error {
+	// Read venue by its ID.
+	venue, err := h.service.ReadVenue(ctx, req.GetVenueId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadVenueResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read venue with ID '%s'", req.GetVenueId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadVenueResponse_Venue{
+		Venue: venue,
+	}
+	return nil
+}
+
+// ListVenues implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list the venues.
+func (h *Handler) ListVenues(ctx context.Context, req *eventproto.ListVenuesRequest, resp *eventproto.ListVenuesResponse) error {
+	// List venues.
+	venues, err := h.service.ListVenues(ctx)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListVenuesResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to list venues")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListVenuesResponse_Data{
+		Data: &eventproto.ListVenuesResponseOK{
+			Venues: venues,
+		},
+	}
+	return nil
+}
+
+// UpdateVenue implements eventproto.EventServiceHandler interface.
+// Calls the service's method to update an existing venue by the given ID and input.
+func (h *Handler) UpdateVenue(ctx context.Context, req *eventproto.UpdateVenueRequest, resp *eventproto.UpdateVenueResponse) error {
+	// Update venue by its ID.
+	venue, err := h.service.UpdateVenue(ctx, req.GetVenueId(), req.GetVenue())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.UpdateVenueResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to update venue with ID '%s'", req.GetVenueId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.UpdateVenueResponse_Venue{
+		Venue: venue,
+	}
+	return nil
+}
+
+// DeleteVenue implements eventproto.EventServiceHandler interface.
+// Calls the service's method to delete an existing venue by the given ID.
+func (h *Handler) DeleteVenue(ctx context.Context, req *eventproto.DeleteVenueRequest, resp *eventproto.DeleteVenueResponse) error {
+	// Delete venue by its ID.
+	if err := h.service.DeleteVenue(ctx, req.GetVenueId()); err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.DeleteVenueResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to delete venue with ID '%s'", req.GetVenueId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.DeleteVenueResponse_Empty{
+		Empty: &empty.Empty{},
+	}
+	return nil
+}
+
+// CreateTemplate implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create a new event template by the given input.
+func (h *Handler) CreateTemplate(ctx context.Context, req *eventproto.CreateTemplateRequest, resp *eventproto.CreateTemplateResponse) error {
+	// Create template.
+	template, err := h.service.CreateTemplate(ctx, req.GetTemplate(), req.GetEventId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateTemplateResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to create template")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateTemplateResponse_Template{
+		Template: template,
+	}
+	return nil
+}
+
+// ReadTemplate implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read an existing event template by the given ID.
+func (h *Handler) ReadTemplate(ctx context.Context, req *eventproto.ReadTemplateRequest, resp *eventproto.ReadTemplateResponse) error {
+	// Read template by its ID.
+	template, err := h.service.ReadTemplate(ctx, req.GetTemplateId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadTemplateResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read template with ID '%s'", req.GetTemplateId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadTemplateResponse_Template{
+		Template: template,
+	}
+	return nil
+}
+
+// ListTemplates implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list the event templates of the given owner.
+func (h *Handler) ListTemplates(ctx context.Context, req *eventproto.ListTemplatesRequest, resp *eventproto.ListTemplatesResponse) error {
+	// List templates of the owner.
+	templates, err := h.service.ListTemplates(ctx, req.GetOwnerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListTemplatesResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to list templates of user with ID '%s'", req.GetOwnerId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListTemplatesResponse_Data{
+		Data: &eventproto.ListTemplatesResponseOK{
+			Templates: templates,
+		},
+	}
+	return nil
+}
+
+// UpdateTemplate implements eventproto.EventServiceHandler interface.
+// Calls the service's method to update an existing event template by the given ID.
+func (h *Handler) UpdateTemplate(ctx context.Context, req *eventproto.UpdateTemplateRequest, resp *eventproto.UpdateTemplateResponse) error {
+	// Update template by its ID.
+	template, err := h.service.UpdateTemplate(ctx, req.GetTemplateId(), req.GetTemplate())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.UpdateTemplateResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to update template with ID '%s'", req.GetTemplateId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.UpdateTemplateResponse_Template{
+		Template: template,
+	}
+	return nil
+}
+
+// DeleteTemplate implements eventproto.EventServiceHandler interface.
+// Calls the service's method to delete an existing event template by the given ID.
+func (h *Handler) DeleteTemplate(ctx context.Context, req *eventproto.DeleteTemplateRequest, resp *eventproto.DeleteTemplateResponse) error {
+	// Delete template by its ID.
+	if err := h.service.DeleteTemplate(ctx, req.GetTemplateId(), req.GetOwnerId()); err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.DeleteTemplateResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to delete template with ID '%s'", req.GetTemplateId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.DeleteTemplateResponse_Empty{
+		Empty: &empty.Empty{},
+	}
+	return nil
+}
+
+// CreateEventFromTemplate implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create a new event from an existing event template.
+func (h *Handler) CreateEventFromTemplate(ctx context.Context, req *eventproto.CreateEventFromTemplateRequest, resp *eventproto.CreateEventFromTemplateResponse) error {
+	// Create event from the template by its ID.
+	event, err := h.service.CreateEventFromTemplate(ctx, req.GetTemplateId(), req.GetCreator(), req.GetStartTime())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateEventFromTemplateResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to create event from template with ID '%s'", req.GetTemplateId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateEventFromTemplateResponse_Event{
+		Event: event,
+	}
+	return nil
+}
+
+// CreateComment implements eventproto.CommentServiceHandler interface.
+// Calls the service's method to comment an existing event.
+func (h *Handler) CreateComment(ctx context.Context, req *eventproto.CreateCommentRequest, resp *eventproto.CreateCommentResponse) error {
+	// Create comment of the event by its ID.
+	comment, err := h.service.CreateComment(ctx, req.GetEventId(), req.GetAuthor(), req.GetText())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateCommentResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to create comment of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateCommentResponse_Comment{
+		Comment: comment,
+	}
+	return nil
+}
+
+// ListComments implements eventproto.CommentServiceHandler interface.
+// Calls the service's method to list a page of the comments of an event.
+func (h *Handler) ListComments(ctx context.Context, req *eventproto.ListCommentsRequest, resp *eventproto.ListCommentsResponse) error {
+	// List comments of the event by its ID.
+	comments, nextPageToken, err := h.service.ListComments(ctx, req.GetEventId(), req.GetViewerId(), req.GetPageToken(), req.GetLimit())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListCommentsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to list comments of event with ID '%s'", req.GetEventId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListCommentsResponse_Data{
+		Data: &eventproto.ListCommentsResponseOK{
+			Comments:      comments,
+			NextPageToken: nextPageToken,
+		},
+	}
+	return nil
+}
+
+// UpdateComment implements eventproto.CommentServiceHandler interface.
+// Calls the service's method to edit an existing comment by the given ID.
+func (h *Handler) UpdateComment(ctx context.Context, req *eventproto.UpdateCommentRequest, resp *eventproto.UpdateCommentResponse) error {
+	// Update comment by its ID.
+	comment, err := h.service.UpdateComment(ctx, req.GetCommentId(), req.GetUserId(), req.GetText())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.UpdateCommentResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to update comment with ID '%s'", req.GetCommentId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.UpdateCommentResponse_Comment{
+		Comment: comment,
+	}
+	return nil
+}
+
+// DeleteComment implements eventproto.CommentServiceHandler interface.
+// Calls the service's method to delete an existing comment by the given ID.
+func (h *Handler) DeleteComment(ctx context.Context, req *eventproto.DeleteCommentRequest, resp *eventproto.DeleteCommentResponse) error {
+	// Delete comment by its ID.
+	if err := h.service.DeleteComment(ctx, req.GetCommentId(), req.GetUserId()); err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.DeleteCommentResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to delete comment with ID '%s'", req.GetCommentId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.DeleteCommentResponse_Empty{
+		Empty: &empty.Empty{},
+	}
+	return nil
+}
+
+// CreateLeague implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create a new league with its teams.
+func (h *Handler) CreateLeague(ctx context.Context, req *eventproto.CreateLeagueRequest, resp *eventproto.CreateLeagueResponse) error {
+	// Create league with its teams.
+	league, err := h.service.CreateLeague(ctx, req.GetLeague())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateLeagueResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to create league")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateLeagueResponse_League{
+		League: league,
+	}
+	return nil
+}
+
+// ReadLeague implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read an existing league by the given ID.
+func (h *Handler) ReadLeague(ctx context.Context, req *eventproto.ReadLeagueRequest, resp *eventproto.ReadLeagueResponse) error {
+	// Read league by its ID.
+	league, err := h.service.ReadLeague(ctx, req.GetLeagueId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadLeagueResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read league with ID '%s'", req.GetLeagueId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadLeagueResponse_League{
+		League: league,
+	}
+	return nil
+}
+
+// ListLeagues implements eventproto.EventServiceHandler interface.
+// Calls the service's method to list all the leagues.
+func (h *Handler) ListLeagues(ctx context.Context, req *eventproto.ListLeaguesRequest, resp *eventproto.ListLeaguesResponse) error {
+	// List all leagues.
+	leagues, err := h.service.ListLeagues(ctx)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ListLeaguesResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrap(err, "unable to list leagues")
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ListLeaguesResponse_Data{
+		Data: &eventproto.ListLeaguesResponseOK{
+			Leagues: leagues,
+		},
+	}
+	return nil
+}
+
+// CreateSeason implements eventproto.EventServiceHandler interface.
+// Calls the service's method to create a new season of an existing league.
+func (h *Handler) CreateSeason(ctx context.Context, req *eventproto.CreateSeasonRequest, resp *eventproto.CreateSeasonResponse) error {
+	// Create season on behalf of the organizer of the league.
+	season, err := h.service.CreateSeason(ctx, req.GetSeason(), req.GetOrganizerId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.CreateSeasonResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to create season of league with ID '%s'", req.GetSeason().GetLeagueId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.CreateSeasonResponse_Season{
+		Season: season,
+	}
+	return nil
+}
+
+// ReadSeason implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read an existing season by the given ID.
+func (h *Handler) ReadSeason(ctx context.Context, req *eventproto.ReadSeasonRequest, resp *eventproto.ReadSeasonResponse) error {
+	// Read season by its ID.
+	season, err := h.service.ReadSeason(ctx, req.GetSeasonId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadSeasonResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read season with ID '%s'", req.GetSeasonId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadSeasonResponse_Season{
+		Season: season,
+	}
+	return nil
+}
+
+// GenerateFixtures implements eventproto.EventServiceHandler interface.
+// Calls the service's method to generate the round-robin fixtures of an existing season.
+func (h *Handler) GenerateFixtures(ctx context.Context, req *eventproto.GenerateFixturesRequest, resp *eventproto.GenerateFixturesResponse) error {
+	// Generate fixtures as events against the given venues and dates.
+	season, err := h.service.GenerateFixtures(ctx, req)
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.GenerateFixturesResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to generate fixtures of season with ID '%s'", req.GetSeasonId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.GenerateFixturesResponse_Season{
+		Season: season,
+	}
+	return nil
+}
+
+// ReadStandings implements eventproto.EventServiceHandler interface.
+// Calls the service's method to read the standings of an existing season by the given ID.
+func (h *Handler) ReadStandings(ctx context.Context, req *eventproto.ReadStandingsRequest, resp *eventproto.ReadStandingsResponse) error {
+	// Derive standings from the results of the fixtures.
+	standings, err := h.service.ReadStandings(ctx, req.GetSeasonId())
+	if err != nil {
+		// Try to convert the given error to the proto status.
+		if resStatus, ok := h.errorAsStatus(ctx, err); ok {
+			resp.Result = &eventproto.ReadStandingsResponse_Error{
+				Error: resStatus,
+			}
+			return nil
+		}
+
+		// Otherwise just return this error wrapped to a description.
+		return errors.Wrapf(err, "unable to read standings of season with ID '%s'", req.GetSeasonId())
+	}
+
+	// Prepare RPC response data.
+	resp.Result = &eventproto.ReadStandingsResponse_Data{
+		Data: &eventproto.ReadStandingsResponseOK{
+			Standings: standings,
+		},
+	}
+	return nil
+}
+
 // Health implements eventproto.EventServiceHandler interface
 func (h *Handler) Health(ctx context.Context, _ *empty.Empty, res *health.HealthResponse) error {
 	// Check database
@@ -194,10 +1685,17 @@ func (h *Handler) Ping(ctx context.Context, _ *empty.Empty, _ *empty.Empty) erro
 
 // errorAsStatus converts the given error to the proto status.
 // This function have to be implemented according to the logic of your project.
-// For now, it always returns the ErrAborted RPC status code.
+// For now, it returns the ErrFailedPrecondition RPC status code for the errors caused by the state of an event,
+// and the ErrAborted RPC status code otherwise.
 // What will be returned:
 // - the first parameter if the proto status of the error;
 // - the second boolean value is true, if the error has been matched with one of RPC statuses;
 func (h *Handler) errorAsStatus(ctx context.Context, err error) (*proto.Status, bool) {
+	switch errors.Cause(err) {
+	case controller.ErrInvalidTransition, controller.ErrEventCompleted, controller.ErrEventNotPublished,
+		controller.ErrEventNotOver, controller.ErrResultConfirmed, controller.ErrVenueBooked, controller.ErrFixturesGenerated:
+		return rpc.ErrFailedPreconditionf(err.Error()), true
+	}
+
 	return rpc.ErrAbortedf(err.Error()), true
 }
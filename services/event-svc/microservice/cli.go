@@ -1,6 +1,8 @@
 package microservice
 
 import (
+	"time"
+
 	"github.com/micro/cli/v2"
 )
 
@@ -14,4 +16,57 @@ var flags = []cli.Flag{
 		Usage:       "Set to true if we are running in docker-compose",
 		Destination: &opts.IsTest,
 	},
+	&cli.Int64Flag{
+		Name:        "event_capacity",
+		EnvVars:     []string{"EVENT_CAPACITY"},
+		Usage:       "The default maximum number of attendees of an event, 0 means unlimited",
+		Destination: &opts.EventCapacity,
+	},
+	&cli.StringFlag{
+		Name:        "checkin_secret",
+		EnvVars:     []string{"CHECKIN_SECRET"},
+		Usage:       "The secret used to sign the check-in codes",
+		Destination: &opts.CheckInSecret,
+	},
+	&cli.DurationFlag{
+		Name:        "checkin_code_ttl",
+		EnvVars:     []string{"CHECKIN_CODE_TTL"},
+		Usage:       "The lifetime of the check-in codes",
+		Value:       2 * time.Minute,
+		Destination: &opts.CheckInCodeTTL,
+	},
+	&cli.DurationFlag{
+		Name:        "result_confirmation_window",
+		EnvVars:     []string{"RESULT_CONFIRMATION_WINDOW"},
+		Usage:       "The time during which a recorded result can be changed, only corrections are allowed afterwards",
+		Value:       48 * time.Hour,
+		Destination: &opts.ResultConfirmationWindow,
+	},
+	&cli.StringFlag{
+		Name:        "invite_secret",
+		EnvVars:     []string{"INVITE_SECRET"},
+		Usage:       "The secret used to sign the invite links",
+		Destination: &opts.InviteSecret,
+	},
+	&cli.DurationFlag{
+		Name:        "invite_link_ttl",
+		EnvVars:     []string{"INVITE_LINK_TTL"},
+		Usage:       "The lifetime of the invite links",
+		Value:       7 * 24 * time.Hour,
+		Destination: &opts.InviteLinkTTL,
+	},
+	&cli.DurationFlag{
+		Name:        "scheduler_interval",
+		EnvVars:     []string{"SCHEDULER_INTERVAL"},
+		Usage:       "The interval at which the background jobs run, e.g. the decisions of the events at their deadline",
+		Value:       time.Minute,
+		Destination: &opts.SchedulerInterval,
+	},
+	&cli.StringFlag{
+		Name:        "reminder_offsets",
+		EnvVars:     []string{"REMINDER_OFFSETS"},
+		Usage:       "The comma-separated times before the start of an event its reminders are sent, empty to disable them",
+		Value:       "24h,1h",
+		Destination: &opts.ReminderOffsets,
+	},
 }
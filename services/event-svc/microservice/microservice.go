@@ -1,6 +1,8 @@
 package microservice
 
 import (
+	"context"
+
 	"github.com/micro/go-micro/v2"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -12,20 +14,22 @@ import (
 	"github.com/marboga/gametimehero/services/event-svc/store/memory"
 	"github.com/marboga/gametimehero/utils/healthchecker"
 	"github.com/marboga/gametimehero/utils/rpc"
+	"github.com/marboga/gametimehero/utils/scheduler"
 )
 
 // MicroService is the micro-service.
 type MicroService struct {
-	svc     micro.Service
-	handler *eventsvc.Handler
-	log     *logrus.Logger
+	svc       micro.Service
+	handler   *eventsvc.Handler
+	scheduler *scheduler.Scheduler
+	log       *logrus.Logger
 }
 
 // Init initializes the service.
 func Init(clientOpts *ClientOptions) (*MicroService, error) {
 	// Create micro-service.
 	svc := micro.NewService(
-		micro.Name(rpc.AccountServiceName),
+		micro.Name(rpc.EventServiceName),
 		micro.Version(clientOpts.Version),
 		micro.Flags(flags...),
 		micro.BeforeStart(func() error {
@@ -42,7 +46,7 @@ func Init(clientOpts *ClientOptions) (*MicroService, error) {
 // New is the constructor of the service.
 func New(svc micro.Service, clientOpts *ClientOptions) (*MicroService, error) {
 	// Create a self-pinger client.
-	selfPingClient := health.NewSelfPingClient(svc, eventproto.NewAccountService(rpc.AccountServiceName, svc.Client()))
+	selfPingClient := health.NewSelfPingClient(svc, eventproto.NewEventService(rpc.EventServiceName, svc.Client()))
 
 	// Create store layer using in-memory data store.
 	// Here can be any implementation of the store layer.
@@ -50,11 +54,41 @@ func New(svc micro.Service, clientOpts *ClientOptions) (*MicroService, error) {
 		Log: clientOpts.Log,
 	})
 
+	reminderOffsets, err := opts.reminderOffsets()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid options")
+	}
+
 	// Create business layer.
 	service := controller.New(&controller.Options{
-		Store: store,
-		Log:   clientOpts.Log,
+		Store:                    store,
+		Log:                      clientOpts.Log,
+		Promotions:               micro.NewEvent(rpc.AttendeePromotedTopic, svc.Client()),
+		Decisions:                micro.NewEvent(rpc.EventDecidedTopic, svc.Client()),
+		Reminders:                micro.NewEvent(rpc.EventReminderTopic, svc.Client()),
+		ReminderOffsets:          reminderOffsets,
+		Capacity:                 opts.EventCapacity,
+		CheckInSecret:            opts.CheckInSecret,
+		CheckInCodeTTL:           opts.CheckInCodeTTL,
+		ResultConfirmationWindow: opts.ResultConfirmationWindow,
+		InviteSecret:             opts.InviteSecret,
+		InviteLinkTTL:            opts.InviteLinkTTL,
+	})
+
+	// Seed the event type catalog, the in-memory store starts empty.
+	for _, eventType := range controller.DefaultEventTypes() {
+		if _, err := service.CreateEventType(context.Background(), eventType); err != nil {
+			return nil, errors.Wrap(err, "failed to seed event types")
+		}
+	}
+
+	// Decide the events reaching their decision deadline and remind the attendees of the upcoming ones in the background.
+	jobs := scheduler.New(&scheduler.Options{
+		Interval: opts.SchedulerInterval,
+		Log:      clientOpts.Log,
 	})
+	jobs.Add("decisions", service.DecideEvents)
+	jobs.Add("reminders", service.SendReminders)
 
 	// Create RPC handler.
 	handler := eventsvc.NewHandler(&eventsvc.Options{
@@ -64,14 +98,20 @@ func New(svc micro.Service, clientOpts *ClientOptions) (*MicroService, error) {
 	})
 
 	// Register the service.
-	if err := eventproto.RegisterAccountServiceHandler(svc.Server(), handler); err != nil {
+	if err := eventproto.RegisterEventServiceHandler(svc.Server(), handler); err != nil {
 		return nil, errors.Wrap(err, "failed to register handler")
 	}
 
+	// The same handler serves the comments of the events.
+	if err := eventproto.RegisterCommentServiceHandler(svc.Server(), handler); err != nil {
+		return nil, errors.Wrap(err, "failed to register comment handler")
+	}
+
 	return &MicroService{
-		svc:     svc,
-		handler: handler,
-		log:     clientOpts.Log,
+		svc:       svc,
+		handler:   handler,
+		scheduler: jobs,
+		log:       clientOpts.Log,
 	}, nil
 }
 
@@ -87,6 +127,15 @@ func (s *MicroService) Run() error {
 	// Stop helathcheck endpoint after RPC service stop.
 	s.svc.Init(micro.AfterStop(shutdown))
 
+	// Run the background jobs while the RPC service runs, so their events can be published.
+	s.svc.Init(
+		micro.AfterStart(func() error {
+			s.scheduler.Start()
+			return nil
+		}),
+		micro.BeforeStop(s.scheduler.Stop),
+	)
+
 	// Start service.
 	if err := s.svc.Run(); err != nil {
 		return errors.Wrap(err, "failed to run")
@@ -1,17 +1,83 @@
 package microservice
 
-import "github.com/sirupsen/logrus"
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
 
 // Options contains the configuration parameters of the service.
 type Options struct {
-	IsTest bool
+	IsTest                   bool
+	EventCapacity            int64
+	CheckInSecret            string
+	CheckInCodeTTL           time.Duration
+	ResultConfirmationWindow time.Duration
+	InviteSecret             string
+	InviteLinkTTL            time.Duration
+	SchedulerInterval        time.Duration
+	ReminderOffsets          string
 }
 
 // Validate applies the validation logic to the options.
 func (opts *Options) Validate() error {
+	if opts.EventCapacity < 0 {
+		return errors.New("event capacity must not be negative")
+	}
+
+	if opts.CheckInSecret == "" {
+		return errors.New("check-in secret is required")
+	}
+
+	if opts.CheckInCodeTTL <= 0 {
+		return errors.New("check-in code TTL must be positive")
+	}
+
+	if opts.ResultConfirmationWindow <= 0 {
+		return errors.New("result confirmation window must be positive")
+	}
+
+	if opts.InviteSecret == "" {
+		return errors.New("invite secret is required")
+	}
+
+	if opts.InviteLinkTTL <= 0 {
+		return errors.New("invite link TTL must be positive")
+	}
+
+	if opts.SchedulerInterval <= 0 {
+		return errors.New("scheduler interval must be positive")
+	}
+
+	if _, err := opts.reminderOffsets(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// reminderOffsets parses the comma-separated reminder offsets, they must be positive whole minutes.
+func (opts *Options) reminderOffsets() ([]time.Duration, error) {
+	var offsets []time.Duration
+	for _, value := range strings.Split(opts.ReminderOffsets, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		offset, err := time.ParseDuration(value)
+		if err != nil || offset < time.Minute || offset%time.Minute != 0 {
+			return nil, errors.Errorf("reminder offset '%s' must be a positive number of minutes, e.g. 90m or 24h", value)
+		}
+
+		offsets = append(offsets, offset)
+	}
+
+	return offsets, nil
+}
+
 // ClientOptions represent external dependencies.
 type ClientOptions struct {
 	Version string
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CreateAttendance implements store.Store interface.
+// This function stores the given attendance record unless the user already has one for the event
+// or the check-in code of the record was already used.
+func (m *memory) CreateAttendance(ctx context.Context, input *eventproto.Attendance) (*eventproto.Attendance, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Return the existing record of the user, if any.
+	for _, attendance := range m.attendances[input.GetEventId()] {
+		if attendance.GetUser().GetId() == input.GetUser().GetId() {
+			return attendance, nil
+		}
+	}
+
+	// Return the record which used the same check-in code, if any.
+	if input.GetCode() != "" {
+		for _, attendance := range m.attendances[input.GetEventId()] {
+			if attendance.GetCode() == input.GetCode() {
+				return attendance, nil
+			}
+		}
+	}
+
+	// Store the attendance.
+	m.attendances[input.GetEventId()] = append(m.attendances[input.GetEventId()], input)
+
+	return input, nil
+}
+
+// ListAttendances implements store.Store interface.
+// This function lists the attendance records of an event.
+func (m *memory) ListAttendances(ctx context.Context, eventID string) ([]*eventproto.Attendance, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	attendances := make([]*eventproto.Attendance, len(m.attendances[eventID]))
+	copy(attendances, m.attendances[eventID])
+
+	return attendances, nil
+}
+
+// ListUserAttendances implements store.Store interface.
+// This function lists the attendance records of a user.
+func (m *memory) ListUserAttendances(ctx context.Context, userID string) ([]*eventproto.Attendance, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	var attendances []*eventproto.Attendance
+	for _, eventAttendances := range m.attendances {
+		for _, attendance := range eventAttendances {
+			if attendance.GetUser().GetId() == userID {
+				attendances = append(attendances, attendance)
+			}
+		}
+	}
+
+	return attendances, nil
+}
@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pborman/uuid"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CreateComment implements store.Store interface.
+// This function stores the given comment at the end of the thread of its event.
+func (m *memory) CreateComment(ctx context.Context, input *eventproto.Comment) (*eventproto.Comment, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Generate a new comment ID.
+	input.Id = uuid.New()
+
+	// Set timestamps, the creation times of a thread are strictly increasing to keep the pages stable.
+	now := time.Now()
+	thread := m.comments[input.GetEventId()]
+	if len(thread) > 0 {
+		if last, _ := ptypes.Timestamp(thread[len(thread)-1].GetCreatedAt()); !now.After(last) {
+			now = last.Add(time.Nanosecond)
+		}
+	}
+	input.CreatedAt, _ = ptypes.TimestampProto(now)
+	input.UpdatedAt = input.CreatedAt
+
+	// Store the comment.
+	m.comments[input.GetEventId()] = append(thread, input)
+	m.commentEvents[input.GetId()] = input.GetEventId()
+
+	return input, nil
+}
+
+// ReadComment implements store.Store interface.
+// This function reads an existing comment by its ID.
+func (m *memory) ReadComment(ctx context.Context, id string) (*eventproto.Comment, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve comment with the given ID.
+	i, ok := m.commentIndex(id)
+	if !ok {
+		return nil, fmt.Errorf("comment with ID '%s' doesn't found", id)
+	}
+
+	return m.comments[m.commentEvents[id]][i], nil
+}
+
+// ListComments implements store.Store interface.
+// This function lists the comments of an event created after the given time.
+func (m *memory) ListComments(ctx context.Context, eventID string, after time.Time, limit int) ([]*eventproto.Comment, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Find the first comment of the page, the thread is sorted by creation time.
+	thread := m.comments[eventID]
+	lo := sort.Search(len(thread), func(i int) bool {
+		createdAt, _ := ptypes.Timestamp(thread[i].GetCreatedAt())
+		return createdAt.After(after)
+	})
+
+	// Prepare data to return.
+	var comments []*eventproto.Comment
+	for i := lo; i < len(thread) && (limit <= 0 || len(comments) < limit); i++ {
+		comments = append(comments, thread[i])
+	}
+
+	return comments, nil
+}
+
+// ModifyComment implements store.Store interface.
+// This function modifies an existing comment by its ID while holding the lock.
+func (m *memory) ModifyComment(ctx context.Context, id string, modify func(*eventproto.Comment) error) (*eventproto.Comment, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve comment with the given ID.
+	i, ok := m.commentIndex(id)
+	if !ok {
+		return nil, fmt.Errorf("comment with ID '%s' doesn't found", id)
+	}
+
+	// Modify a copy to keep the stored record untouched if the modification fails.
+	thread := m.comments[m.commentEvents[id]]
+	modified := proto.Clone(thread[i]).(*eventproto.Comment)
+	if err := modify(modified); err != nil {
+		return nil, err
+	}
+
+	// Update comment record, keeping its identity and place in the thread.
+	modified.Id = thread[i].GetId()
+	modified.EventId = thread[i].GetEventId()
+	modified.CreatedAt = thread[i].GetCreatedAt()
+	modified.UpdatedAt = ptypes.TimestampNow()
+	thread[i] = modified
+
+	return modified, nil
+}
+
+// DeleteComment implements store.Store interface.
+// This function deletes an existing comment by its ID.
+func (m *memory) DeleteComment(ctx context.Context, id string) error {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve comment with the given ID.
+	i, ok := m.commentIndex(id)
+	if !ok {
+		return fmt.Errorf("comment with ID '%s' doesn't found", id)
+	}
+
+	// Delete record.
+	eventID := m.commentEvents[id]
+	m.comments[eventID] = append(m.comments[eventID][:i], m.comments[eventID][i+1:]...)
+	delete(m.commentEvents, id)
+
+	return nil
+}
+
+// commentIndex returns the position of the comment with the given ID in the thread of its event.
+func (m *memory) commentIndex(id string) (int, bool) {
+	eventID, ok := m.commentEvents[id]
+	if !ok {
+		return 0, false
+	}
+
+	for i, comment := range m.comments[eventID] {
+		if comment.GetId() == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// deleteComments deletes the whole thread of an event.
+func (m *memory) deleteComments(eventID string) {
+	for _, comment := range m.comments[eventID] {
+		delete(m.commentEvents, comment.GetId())
+	}
+	delete(m.comments, eventID)
+}
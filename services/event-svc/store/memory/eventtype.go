@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CreateEventType implements store.Store interface.
+// This function stores the given event type under its own ID.
+func (m *memory) CreateEventType(ctx context.Context, input *eventproto.EventType) (*eventproto.EventType, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// The ID is chosen by the caller, so it must be unique.
+	if _, ok := m.eventTypes[input.GetId()]; ok {
+		return nil, fmt.Errorf("event type with ID '%s' already exists", input.GetId())
+	}
+
+	// Set timestamps
+	now := ptypes.TimestampNow()
+	input.CreatedAt = now
+	input.UpdatedAt = now
+
+	// Store the event type
+	m.eventTypes[input.GetId()] = input
+
+	return input, nil
+}
+
+// ReadEventType implements store.Store interface.
+// This function reads an existing event type by its ID.
+func (m *memory) ReadEventType(ctx context.Context, id string) (*eventproto.EventType, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve event type with the given ID.
+	eventType, ok := m.eventTypes[id]
+	if !ok {
+		return nil, fmt.Errorf("event type with ID '%s' doesn't found", id)
+	}
+
+	return eventType, nil
+}
+
+// ListEventTypes implements store.Store interface.
+// This function lists all event types ordered by their ID.
+func (m *memory) ListEventTypes(ctx context.Context) ([]*eventproto.EventType, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	eventTypes := make([]*eventproto.EventType, 0, len(m.eventTypes))
+	for _, eventType := range m.eventTypes {
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	sort.Slice(eventTypes, func(i, j int) bool {
+		return eventTypes[i].GetId() < eventTypes[j].GetId()
+	})
+
+	return eventTypes, nil
+}
+
+// UpdateEventType implements store.Store interface.
+// This function updates an existing event type by its ID.
+func (m *memory) UpdateEventType(ctx context.Context, id string, input *eventproto.EventType) (*eventproto.EventType, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve event type with the given ID.
+	eventType, ok := m.eventTypes[id]
+	if !ok {
+		return nil, fmt.Errorf("event type with ID '%s' doesn't found", id)
+	}
+
+	// Update event type record, keeping its identity and creation time.
+	input.Id = eventType.GetId()
+	input.CreatedAt = eventType.GetCreatedAt()
+	input.UpdatedAt = ptypes.TimestampNow()
+	m.eventTypes[id] = input
+
+	return input, nil
+}
+
+// DeleteEventType implements store.Store interface.
+// This function deletes an existing event type by its ID.
+func (m *memory) DeleteEventType(ctx context.Context, id string) error {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve event type with the given ID.
+	if _, ok := m.eventTypes[id]; !ok {
+		return fmt.Errorf("event type with ID '%s' doesn't found", id)
+	}
+
+	// Delete record.
+	delete(m.eventTypes, id)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pborman/uuid"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// ReadCalendarFeed implements store.Store interface.
+// This function reads the calendar feed of a user by its ID, or stores a new one.
+func (m *memory) ReadCalendarFeed(ctx context.Context, userID string) (*eventproto.CalendarFeed, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	feed, ok := m.calendarFeeds[userID]
+	if !ok {
+		feed = m.rotateCalendarFeed(userID)
+	}
+
+	return proto.Clone(feed).(*eventproto.CalendarFeed), nil
+}
+
+// RotateCalendarFeed implements store.Store interface.
+// This function stores the calendar feed of a user by its ID with a new salt.
+func (m *memory) RotateCalendarFeed(ctx context.Context, userID string) (*eventproto.CalendarFeed, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	return proto.Clone(m.rotateCalendarFeed(userID)).(*eventproto.CalendarFeed), nil
+}
+
+// rotateCalendarFeed stores the calendar feed of a user with a new random salt. The lock must be held by the caller.
+func (m *memory) rotateCalendarFeed(userID string) *eventproto.CalendarFeed {
+	feed := &eventproto.CalendarFeed{
+		UserId:    userID,
+		Salt:      uuid.New(),
+		RotatedAt: ptypes.TimestampNow(),
+	}
+	m.calendarFeeds[userID] = feed
+
+	return feed
+}
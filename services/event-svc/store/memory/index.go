@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/geo"
+	"github.com/marboga/gametimehero/utils/search"
+)
+
+// locationPrecision is the geohash precision of the location index, i.e. cells of about 1.2km x 0.6km.
+const locationPrecision = 6
+
+// The weights of the fields of the events in the full-text index, a word of the name weighs more than the same word
+// in the description.
+const (
+	nameWeight        = 3
+	eventTypeWeight   = 2
+	descriptionWeight = 1
+)
+
+// startKey is an entry of the start time index.
+// Events without a start time have the maximum key, so they are sorted after all others.
+type startKey struct {
+	seconds int64
+	nanos   int32
+	id      string
+}
+
+// newStartKey returns the start time index key of the event with the given ID and start time.
+func newStartKey(id string, ts *timestamp.Timestamp) startKey {
+	if ts == nil {
+		return startKey{seconds: math.MaxInt64, id: id}
+	}
+
+	return startKey{seconds: ts.GetSeconds(), nanos: ts.GetNanos(), id: id}
+}
+
+// timeKey returns the smallest start time index key of the given time.
+func timeKey(t time.Time) startKey {
+	return startKey{seconds: t.Unix(), nanos: int32(t.Nanosecond())}
+}
+
+// less reports whether the key k is sorted before the key o.
+func (k startKey) less(o startKey) bool {
+	if k.seconds != o.seconds {
+		return k.seconds < o.seconds
+	}
+	if k.nanos != o.nanos {
+		return k.nanos < o.nanos
+	}
+	return k.id < o.id
+}
+
+// search returns the position of the first key of the start time index which isn't sorted before the given one.
+func (m *memory) search(key startKey) int {
+	return sort.Search(len(m.starts), func(i int) bool {
+		return !m.starts[i].less(key)
+	})
+}
+
+// index adds the given event to the indexes. The lock must be held by the caller.
+func (m *memory) index(event *eventproto.Event) {
+	if location := event.GetLatLong(); location != nil {
+		hash := geo.Encode(location.GetLatitude(), location.GetLongitude(), locationPrecision)
+		for i := 1; i <= len(hash); i++ {
+			ids, ok := m.locations[hash[:i]]
+			if !ok {
+				ids = make(map[string]struct{})
+				m.locations[hash[:i]] = ids
+			}
+			ids[event.GetId()] = struct{}{}
+		}
+	}
+
+	key := newStartKey(event.GetId(), event.GetStartTime())
+	i := m.search(key)
+	m.starts = append(m.starts, startKey{})
+	copy(m.starts[i+1:], m.starts[i:])
+	m.starts[i] = key
+
+	m.text.Put(event.GetId(),
+		search.Field{Text: event.GetName(), Weight: nameWeight},
+		search.Field{Text: event.GetEventType(), Weight: eventTypeWeight},
+		search.Field{Text: event.GetDescription(), Weight: descriptionWeight},
+	)
+
+	if event.GetRecurrence() != nil {
+		m.recurring[event.GetId()] = struct{}{}
+	}
+
+	if venueID := event.GetVenueId(); venueID != "" {
+		ids, ok := m.venueEvents[venueID]
+		if !ok {
+			ids = make(map[string]struct{})
+			m.venueEvents[venueID] = ids
+		}
+		ids[event.GetId()] = struct{}{}
+	}
+}
+
+// unindex removes the given event from the indexes. The lock must be held by the caller.
+func (m *memory) unindex(event *eventproto.Event) {
+	if location := event.GetLatLong(); location != nil {
+		hash := geo.Encode(location.GetLatitude(), location.GetLongitude(), locationPrecision)
+		for i := 1; i <= len(hash); i++ {
+			delete(m.locations[hash[:i]], event.GetId())
+			if len(m.locations[hash[:i]]) == 0 {
+				delete(m.locations, hash[:i])
+			}
+		}
+	}
+
+	key := newStartKey(event.GetId(), event.GetStartTime())
+	if i := m.search(key); i < len(m.starts) && m.starts[i] == key {
+		m.starts = append(m.starts[:i], m.starts[i+1:]...)
+	}
+
+	m.text.Delete(event.GetId())
+	delete(m.recurring, event.GetId())
+
+	if venueID := event.GetVenueId(); venueID != "" {
+		delete(m.venueEvents[venueID], event.GetId())
+		if len(m.venueEvents[venueID]) == 0 {
+			delete(m.venueEvents, venueID)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pborman/uuid"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CreateLeague implements store.Store interface.
+// This function stores the given league.
+func (m *memory) CreateLeague(ctx context.Context, input *eventproto.League) (*eventproto.League, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Generate new IDs for the league and its teams.
+	input.Id = uuid.New()
+	for _, team := range input.GetTeams() {
+		team.Id = uuid.New()
+	}
+
+	// Set timestamps
+	now := ptypes.TimestampNow()
+	input.CreatedAt = now
+	input.UpdatedAt = now
+
+	// Store the league
+	m.leagues[input.Id] = input
+
+	return input, nil
+}
+
+// ReadLeague implements store.Store interface.
+// This function reads an existing league by its ID.
+func (m *memory) ReadLeague(ctx context.Context, id string) (*eventproto.League, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve league with the given ID.
+	league, ok := m.leagues[id]
+	if !ok {
+		return nil, fmt.Errorf("league with ID '%s' doesn't found", id)
+	}
+
+	return league, nil
+}
+
+// ListLeagues implements store.Store interface.
+// This function lists all leagues ordered by their name.
+func (m *memory) ListLeagues(ctx context.Context) ([]*eventproto.League, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	leagues := make([]*eventproto.League, 0, len(m.leagues))
+	for _, league := range m.leagues {
+		leagues = append(leagues, league)
+	}
+
+	sort.Slice(leagues, func(i, j int) bool {
+		if leagues[i].GetName() != leagues[j].GetName() {
+			return leagues[i].GetName() < leagues[j].GetName()
+		}
+		return leagues[i].GetId() < leagues[j].GetId()
+	})
+
+	return leagues, nil
+}
+
+// CreateSeason implements store.Store interface.
+// This function stores the given season.
+func (m *memory) CreateSeason(ctx context.Context, input *eventproto.Season) (*eventproto.Season, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Generate a new season ID.
+	input.Id = uuid.New()
+
+	// Set timestamps
+	now := ptypes.TimestampNow()
+	input.CreatedAt = now
+	input.UpdatedAt = now
+
+	// Store the season
+	m.seasons[input.Id] = input
+
+	return input, nil
+}
+
+// ReadSeason implements store.Store interface.
+// This function reads an existing season by its ID.
+func (m *memory) ReadSeason(ctx context.Context, id string) (*eventproto.Season, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve season with the given ID.
+	season, ok := m.seasons[id]
+	if !ok {
+		return nil, fmt.Errorf("season with ID '%s' doesn't found", id)
+	}
+
+	return season, nil
+}
+
+// ModifySeason implements store.Store interface.
+// This function modifies an existing season by its ID while holding the lock.
+func (m *memory) ModifySeason(ctx context.Context, id string, modify func(*eventproto.Season) error) (*eventproto.Season, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve season with the given ID.
+	season, ok := m.seasons[id]
+	if !ok {
+		return nil, fmt.Errorf("season with ID '%s' doesn't found", id)
+	}
+
+	// Modify a copy to keep the stored record untouched if the modification fails.
+	modified := proto.Clone(season).(*eventproto.Season)
+	if err := modify(modified); err != nil {
+		return nil, err
+	}
+
+	// Update season record.
+	modified.UpdatedAt = ptypes.TimestampNow()
+	m.seasons[id] = modified
+
+	return modified, nil
+}
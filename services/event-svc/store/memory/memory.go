@@ -6,13 +6,17 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/pborman/uuid"
 	"github.com/sirupsen/logrus"
 
 	eventproto "github.com/marboga/gametimehero/proto/event-svc"
 	"github.com/marboga/gametimehero/services/event-svc/store"
+	"github.com/marboga/gametimehero/utils/geo"
+	"github.com/marboga/gametimehero/utils/search"
 )
 
 // Options contains the options to create a memory store
@@ -27,13 +31,76 @@ type memory struct {
 
 	data map[string]*eventproto.Event
 	log  *logrus.Logger
+
+	// locations indexes event IDs by every prefix of the geohash of their location.
+	locations map[string]map[string]struct{}
+
+	// starts indexes events by their start time, it's always sorted.
+	starts []startKey
+
+	// text is the full-text index of the events.
+	text *search.Index
+
+	// recurring contains the IDs of the events with a recurrence rule.
+	recurring map[string]struct{}
+
+	// attendances contains the attendance records of every event ID, in order of check-in.
+	attendances map[string][]*eventproto.Attendance
+
+	// comments contains the discussion thread of every event ID, in order of creation.
+	comments map[string][]*eventproto.Comment
+
+	// commentEvents indexes the event IDs by the IDs of their comments.
+	commentEvents map[string]string
+
+	// eventTypes contains the catalog of event types by their ID.
+	eventTypes map[string]*eventproto.EventType
+
+	// results contains the results of the events by their ID.
+	results map[string]*eventproto.MatchResult
+
+	// venues contains the venues by their ID.
+	venues map[string]*eventproto.Venue
+
+	// venueEvents indexes event IDs by the ID of their venue.
+	venueEvents map[string]map[string]struct{}
+
+	// templates contains the event templates by their ID.
+	templates map[string]*eventproto.EventTemplate
+
+	// leagues contains the leagues by their ID.
+	leagues map[string]*eventproto.League
+
+	// seasons contains the seasons of the leagues by their ID.
+	seasons map[string]*eventproto.Season
+
+	// sentReminders contains the reminder records by their event ID, occurrence start time and offset.
+	sentReminders map[reminderKey]*eventproto.SentReminder
+
+	// calendarFeeds contains the calendar feeds by the ID of their user.
+	calendarFeeds map[string]*eventproto.CalendarFeed
 }
 
 // New is the constructor of memory
 func New(opts *Options) store.Store {
 	return &memory{
-		data: make(map[string]*eventproto.Event),
-		log:  opts.Log,
+		data:          make(map[string]*eventproto.Event),
+		log:           opts.Log,
+		locations:     make(map[string]map[string]struct{}),
+		text:          search.New(),
+		recurring:     make(map[string]struct{}),
+		attendances:   make(map[string][]*eventproto.Attendance),
+		comments:      make(map[string][]*eventproto.Comment),
+		commentEvents: make(map[string]string),
+		eventTypes:    make(map[string]*eventproto.EventType),
+		results:       make(map[string]*eventproto.MatchResult),
+		venues:        make(map[string]*eventproto.Venue),
+		venueEvents:   make(map[string]map[string]struct{}),
+		templates:     make(map[string]*eventproto.EventTemplate),
+		leagues:       make(map[string]*eventproto.League),
+		seasons:       make(map[string]*eventproto.Season),
+		sentReminders: make(map[reminderKey]*eventproto.SentReminder),
+		calendarFeeds: make(map[string]*eventproto.CalendarFeed),
 	}
 }
 
@@ -44,6 +111,11 @@ func (m *memory) CreateEvent(ctx context.Context, input *eventproto.Event) (*eve
 	m.Lock()
 	defer m.Unlock()
 
+	return m.createEvent(input), nil
+}
+
+// createEvent stores the given event with a new ID. The lock must be held by the caller.
+func (m *memory) createEvent(input *eventproto.Event) *eventproto.Event {
 	// Generate a new event ID.
 	input.Id = uuid.New()
 
@@ -51,11 +123,13 @@ func (m *memory) CreateEvent(ctx context.Context, input *eventproto.Event) (*eve
 	now := ptypes.TimestampNow()
 	input.CreatedAt = now
 	input.UpdatedAt = now
+	input.Revision = 0
 
 	// Store the event
 	m.data[input.Id] = input
+	m.index(input)
 
-	return input, nil
+	return input
 }
 
 // ReadEvent implements store.Store interface.
@@ -78,7 +152,7 @@ func (m *memory) ReadEvent(ctx context.Context, id string) (*eventproto.Event, e
 }
 
 // ListEvents implements store.Store interface.
-// This function lists all events.
+// This function lists all events ordered by their start time.
 func (m *memory) ListEvents(ctx context.Context) ([]*eventproto.Event, error) {
 	// Protect the data from race condition and data race.
 	m.Lock()
@@ -86,13 +160,93 @@ func (m *memory) ListEvents(ctx context.Context) ([]*eventproto.Event, error) {
 
 	// Prepare data to return.
 	var events []*eventproto.Event
-	for _, event := range m.data {
-		events = append(events, event)
+	for _, key := range m.starts {
+		events = append(events, m.data[key.id])
+	}
+
+	return events, nil
+}
+
+// ListEventsStartingBetween implements store.Store interface.
+// This function lists the events starting within the given time window using the start time index.
+func (m *memory) ListEventsStartingBetween(ctx context.Context, after, before time.Time, descending bool, limit int) ([]*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Find the bounds of the time window in the index.
+	lo, hi := m.search(timeKey(after)), m.search(timeKey(before))
+
+	// Prepare data to return.
+	var events []*eventproto.Event
+	for i := lo; i < hi && (limit <= 0 || len(events) < limit); i++ {
+		key := m.starts[i]
+		if descending {
+			key = m.starts[hi-1-(i-lo)]
+		}
+		events = append(events, m.data[key.id])
+	}
+
+	return events, nil
+}
+
+// ListRecurringEvents implements store.Store interface.
+// This function lists the events with a recurrence rule using the recurrence index.
+func (m *memory) ListRecurringEvents(ctx context.Context) ([]*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	var events []*eventproto.Event
+	for id := range m.recurring {
+		events = append(events, m.data[id])
+	}
+
+	return events, nil
+}
+
+// ListEventsNear implements store.Store interface.
+// This function lists the events located within the given radius using the location index.
+func (m *memory) ListEventsNear(ctx context.Context, center *eventproto.LatLong, radiusKm float64) ([]*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	var events []*eventproto.Event
+	for _, cell := range geo.Cover(center.GetLatitude(), center.GetLongitude(), radiusKm, locationPrecision) {
+		for id := range m.locations[cell] {
+			event := m.data[id]
+			location := event.GetLatLong()
+			if geo.Distance(center.GetLatitude(), center.GetLongitude(), location.GetLatitude(), location.GetLongitude()) <= radiusKm {
+				events = append(events, event)
+			}
+		}
 	}
 
 	return events, nil
 }
 
+// SearchEvents implements store.Store interface.
+// This function lists the events matching the given query using the full-text index.
+func (m *memory) SearchEvents(ctx context.Context, query string) ([]*eventproto.EventMatch, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	var matches []*eventproto.EventMatch
+	for _, result := range m.text.Search(query) {
+		matches = append(matches, &eventproto.EventMatch{
+			Event: m.data[result.ID],
+			Score: result.Score,
+		})
+	}
+
+	return matches, nil
+}
+
 // UpdateEvent implements store.Store interface.
 // This function updates an existing event by its ID.
 func (m *memory) UpdateEvent(ctx context.Context, id string, input *eventproto.Event) (*eventproto.Event, error) {
@@ -101,20 +255,64 @@ func (m *memory) UpdateEvent(ctx context.Context, id string, input *eventproto.E
 	defer m.Unlock()
 
 	// Retrieve event with the given ID.
-	if _, ok := m.data[id]; !ok {
+	event, ok := m.data[id]
+	if !ok {
 		// Return the not found errors.
 		// Here should be custom not found error implementation
 		// to convert in to the proto status instead of return this error.
 		return nil, fmt.Errorf("event with ID '%s' doesn't found", id)
 	}
 
-	// Update event record.
+	// Update event record, keeping its identity and creation time.
+	input.Id = event.GetId()
+	input.CreatedAt = event.GetCreatedAt()
 	input.UpdatedAt = ptypes.TimestampNow()
+	input.Revision = event.GetRevision() + 1
+	m.unindex(event)
 	m.data[id] = input
+	m.index(input)
 
 	return input, nil
 }
 
+// ModifyEvent implements store.Store interface.
+// This function modifies an existing event by its ID while holding the lock.
+func (m *memory) ModifyEvent(ctx context.Context, id string, modify func(*eventproto.Event) error) (*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	return m.modifyEvent(id, modify)
+}
+
+// modifyEvent applies the given function to a copy of the event with the given ID and stores the copy.
+// The lock must be held by the caller.
+func (m *memory) modifyEvent(id string, modify func(*eventproto.Event) error) (*eventproto.Event, error) {
+	// Retrieve event with the given ID.
+	event, ok := m.data[id]
+	if !ok {
+		// Return the not found errors.
+		// Here should be custom not found error implementation
+		// to convert in to the proto status instead of return this error.
+		return nil, fmt.Errorf("event with ID '%s' doesn't found", id)
+	}
+
+	// Modify a copy to keep the stored record untouched if the modification fails.
+	modified := proto.Clone(event).(*eventproto.Event)
+	if err := modify(modified); err != nil {
+		return nil, err
+	}
+
+	// Update event record.
+	modified.UpdatedAt = ptypes.TimestampNow()
+	modified.Revision = event.GetRevision() + 1
+	m.unindex(event)
+	m.data[id] = modified
+	m.index(modified)
+
+	return modified, nil
+}
+
 // DeleteEvent implements store.Store interface.
 // This function deletes an existing event by its ID.
 func (m *memory) DeleteEvent(ctx context.Context, id string) error {
@@ -123,7 +321,8 @@ func (m *memory) DeleteEvent(ctx context.Context, id string) error {
 	defer m.Unlock()
 
 	// Retrieve event with the given ID.
-	if _, ok := m.data[id]; !ok {
+	event, ok := m.data[id]
+	if !ok {
 		// Return the not found errors.
 		// Here should be custom not found error implementation
 		// to convert in to the proto status instead of return this error.
@@ -131,7 +330,11 @@ func (m *memory) DeleteEvent(ctx context.Context, id string) error {
 	}
 
 	// Delete record.
+	m.unindex(event)
 	delete(m.data, id)
+	delete(m.attendances, id)
+	m.deleteComments(id)
+	delete(m.results, id)
 
 	return nil
 }
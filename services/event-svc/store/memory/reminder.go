@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// reminderKey identifies the reminder with an offset of an occurrence of an event.
+type reminderKey struct {
+	eventID       string
+	startTime     int64
+	offsetMinutes int64
+}
+
+// CreateSentReminder implements store.Store interface.
+// This function stores the given reminder record unless the same reminder is already stored.
+// The records are lost on restart, like all the data of this store.
+func (m *memory) CreateSentReminder(ctx context.Context, input *eventproto.SentReminder) (bool, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	startTime, err := ptypes.Timestamp(input.GetStartTime())
+	if err != nil {
+		return false, err
+	}
+
+	key := reminderKey{
+		eventID:       input.GetEventId(),
+		startTime:     startTime.UnixNano(),
+		offsetMinutes: input.GetOffsetMinutes(),
+	}
+
+	// Keep the existing record, if any.
+	if _, ok := m.sentReminders[key]; ok {
+		return false, nil
+	}
+
+	// Store the reminder.
+	m.sentReminders[key] = input
+
+	return true, nil
+}
+
+// DeleteSentRemindersBefore implements store.Store interface.
+// This function deletes the reminder records of the occurrences which started before the given time.
+func (m *memory) DeleteSentRemindersBefore(ctx context.Context, before time.Time) error {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	for key := range m.sentReminders {
+		if key.startTime < before.UnixNano() {
+			delete(m.sentReminders, key)
+		}
+	}
+
+	return nil
+}
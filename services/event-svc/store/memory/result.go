@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// ReadResult implements store.Store interface.
+// This function reads the result of an event by its ID.
+func (m *memory) ReadResult(ctx context.Context, eventID string) (*eventproto.MatchResult, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve result of the event with the given ID.
+	result, ok := m.results[eventID]
+	if !ok {
+		return nil, fmt.Errorf("result of event with ID '%s' doesn't found", eventID)
+	}
+
+	return result, nil
+}
+
+// ModifyResult implements store.Store interface.
+// This function modifies the result of an event by its ID while holding the lock.
+func (m *memory) ModifyResult(ctx context.Context, eventID string, modify func(*eventproto.MatchResult) error) (*eventproto.MatchResult, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Start a new result if the event has none.
+	modified := &eventproto.MatchResult{
+		EventId: eventID,
+	}
+	if result, ok := m.results[eventID]; ok {
+		// Modify a copy to keep the stored record untouched if the modification fails.
+		modified = proto.Clone(result).(*eventproto.MatchResult)
+	}
+
+	if err := modify(modified); err != nil {
+		return nil, err
+	}
+
+	// Store result record.
+	modified.EventId = eventID
+	modified.UpdatedAt = ptypes.TimestampNow()
+	m.results[eventID] = modified
+
+	return modified, nil
+}
+
+// ListResults implements store.Store interface.
+// This function lists the results of the given events.
+func (m *memory) ListResults(ctx context.Context, eventIDs []string) ([]*eventproto.MatchResult, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	var results []*eventproto.MatchResult
+	for _, eventID := range eventIDs {
+		if result, ok := m.results[eventID]; ok {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pborman/uuid"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CreateTemplate implements store.Store interface.
+// This function stores the given template.
+func (m *memory) CreateTemplate(ctx context.Context, input *eventproto.EventTemplate) (*eventproto.EventTemplate, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Generate a new template ID.
+	input.Id = uuid.New()
+
+	// Set timestamps
+	now := ptypes.TimestampNow()
+	input.CreatedAt = now
+	input.UpdatedAt = now
+
+	// Store the template
+	m.templates[input.Id] = input
+
+	return input, nil
+}
+
+// ReadTemplate implements store.Store interface.
+// This function reads an existing template by its ID.
+func (m *memory) ReadTemplate(ctx context.Context, id string) (*eventproto.EventTemplate, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve template with the given ID.
+	template, ok := m.templates[id]
+	if !ok {
+		return nil, fmt.Errorf("template with ID '%s' doesn't found", id)
+	}
+
+	return template, nil
+}
+
+// ListTemplates implements store.Store interface.
+// This function lists the templates of the given owner ordered by their name.
+func (m *memory) ListTemplates(ctx context.Context, ownerID string) ([]*eventproto.EventTemplate, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	var templates []*eventproto.EventTemplate
+	for _, template := range m.templates {
+		if template.GetOwnerId() == ownerID {
+			templates = append(templates, template)
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].GetName() != templates[j].GetName() {
+			return templates[i].GetName() < templates[j].GetName()
+		}
+		return templates[i].GetId() < templates[j].GetId()
+	})
+
+	return templates, nil
+}
+
+// UpdateTemplate implements store.Store interface.
+// This function updates an existing template by its ID.
+func (m *memory) UpdateTemplate(ctx context.Context, id string, input *eventproto.EventTemplate) (*eventproto.EventTemplate, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve template with the given ID.
+	template, ok := m.templates[id]
+	if !ok {
+		return nil, fmt.Errorf("template with ID '%s' doesn't found", id)
+	}
+
+	// Update template record, keeping its identity, owner and creation time.
+	input.Id = template.GetId()
+	input.OwnerId = template.GetOwnerId()
+	input.CreatedAt = template.GetCreatedAt()
+	input.UpdatedAt = ptypes.TimestampNow()
+	m.templates[id] = input
+
+	return input, nil
+}
+
+// DeleteTemplate implements store.Store interface.
+// This function deletes an existing template by its ID.
+func (m *memory) DeleteTemplate(ctx context.Context, id string) error {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve template with the given ID.
+	if _, ok := m.templates[id]; !ok {
+		return fmt.Errorf("template with ID '%s' doesn't found", id)
+	}
+
+	// Delete record.
+	delete(m.templates, id)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pborman/uuid"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+)
+
+// CreateVenue implements store.Store interface.
+// This function stores the given venue.
+func (m *memory) CreateVenue(ctx context.Context, input *eventproto.Venue) (*eventproto.Venue, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Generate a new venue ID.
+	input.Id = uuid.New()
+
+	// Set timestamps
+	now := ptypes.TimestampNow()
+	input.CreatedAt = now
+	input.UpdatedAt = now
+
+	// Store the venue
+	m.venues[input.Id] = input
+
+	return input, nil
+}
+
+// ReadVenue implements store.Store interface.
+// This function reads an existing venue by its ID.
+func (m *memory) ReadVenue(ctx context.Context, id string) (*eventproto.Venue, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve venue with the given ID.
+	venue, ok := m.venues[id]
+	if !ok {
+		return nil, fmt.Errorf("venue with ID '%s' doesn't found", id)
+	}
+
+	return venue, nil
+}
+
+// ListVenues implements store.Store interface.
+// This function lists all venues ordered by their name.
+func (m *memory) ListVenues(ctx context.Context) ([]*eventproto.Venue, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Prepare data to return.
+	venues := make([]*eventproto.Venue, 0, len(m.venues))
+	for _, venue := range m.venues {
+		venues = append(venues, venue)
+	}
+
+	sort.Slice(venues, func(i, j int) bool {
+		if venues[i].GetName() != venues[j].GetName() {
+			return venues[i].GetName() < venues[j].GetName()
+		}
+		return venues[i].GetId() < venues[j].GetId()
+	})
+
+	return venues, nil
+}
+
+// UpdateVenue implements store.Store interface.
+// This function updates an existing venue by its ID.
+func (m *memory) UpdateVenue(ctx context.Context, id string, input *eventproto.Venue) (*eventproto.Venue, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve venue with the given ID.
+	venue, ok := m.venues[id]
+	if !ok {
+		return nil, fmt.Errorf("venue with ID '%s' doesn't found", id)
+	}
+
+	// Update venue record, keeping its identity and creation time.
+	input.Id = venue.GetId()
+	input.CreatedAt = venue.GetCreatedAt()
+	input.UpdatedAt = ptypes.TimestampNow()
+	m.venues[id] = input
+
+	return input, nil
+}
+
+// DeleteVenue implements store.Store interface.
+// This function deletes an existing venue by its ID.
+func (m *memory) DeleteVenue(ctx context.Context, id string) error {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	// Retrieve venue with the given ID.
+	if _, ok := m.venues[id]; !ok {
+		return fmt.Errorf("venue with ID '%s' doesn't found", id)
+	}
+
+	// Delete record.
+	delete(m.venues, id)
+
+	return nil
+}
+
+// ListVenueEvents implements store.Store interface.
+// This function lists the events at the given venue using the venue index.
+func (m *memory) ListVenueEvents(ctx context.Context, venueID string) ([]*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	return m.listVenueEvents(venueID), nil
+}
+
+// CreateVenueEvent implements store.Store interface.
+// This function checks the bookings of the venue and creates the event under the same lock.
+func (m *memory) CreateVenueEvent(ctx context.Context, input *eventproto.Event, check func([]*eventproto.Event) error) (*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	if err := check(m.listVenueEvents(input.GetVenueId())); err != nil {
+		return nil, err
+	}
+
+	return m.createEvent(input), nil
+}
+
+// ModifyVenueEvent implements store.Store interface.
+// This function checks the bookings of the venue and modifies the event under the same lock.
+func (m *memory) ModifyVenueEvent(ctx context.Context, id string, venueID string, modify func(*eventproto.Event, []*eventproto.Event) error) (*eventproto.Event, error) {
+	// Protect the data from race condition and data race.
+	m.Lock()
+	defer m.Unlock()
+
+	return m.modifyEvent(id, func(event *eventproto.Event) error {
+		return modify(event, m.listVenueEvents(venueID))
+	})
+}
+
+// listVenueEvents lists the events at the given venue. The lock must be held by the caller.
+func (m *memory) listVenueEvents(venueID string) []*eventproto.Event {
+	var events []*eventproto.Event
+	for id := range m.venueEvents[venueID] {
+		events = append(events, m.data[id])
+	}
+
+	return events
+}
@@ -4,6 +4,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	eventproto "github.com/marboga/gametimehero/proto/event-svc"
 )
@@ -19,14 +20,169 @@ type Store interface {
 	// ReadEvent reads an existing event by its ID from the store.
 	ReadEvent(context.Context, string) (*eventproto.Event, error)
 
-	// ListEvents lists all events from the store.
+	// ListEvents lists all events from the store ordered by their start time.
+	// Events without a start time are listed last.
 	ListEvents(context.Context) ([]*eventproto.Event, error)
 
+	// ListEventsStartingBetween lists the events starting within the given time window, including its lower bound,
+	// ordered by their start time. The order is reversed if descending is true,
+	// and at most limit events are listed if limit is positive.
+	// The store keeps a start time index, so this function doesn't scan all events.
+	ListEventsStartingBetween(ctx context.Context, after, before time.Time, descending bool, limit int) ([]*eventproto.Event, error)
+
+	// ListRecurringEvents lists the events with a recurrence rule.
+	ListRecurringEvents(context.Context) ([]*eventproto.Event, error)
+
+	// ListEventsNear lists the events located within the given radius in kilometers around the given location.
+	// The store keeps a spatial index, so this function doesn't scan all events.
+	ListEventsNear(context.Context, *eventproto.LatLong, float64) ([]*eventproto.Event, error)
+
+	// SearchEvents lists the events matching every word of the given query, the most relevant first.
+	// The store keeps a full-text index of the names, descriptions and types of the events,
+	// so this function doesn't scan all events.
+	SearchEvents(context.Context, string) ([]*eventproto.EventMatch, error)
+
 	// UpdateEvent updates an existing event in the store by its ID using the given input.
 	// This function only updates the record using the given input. No business logic there.
 	UpdateEvent(context.Context, string, *eventproto.Event) (*eventproto.Event, error)
 
+	// ModifyEvent atomically applies the given function to an existing event found by its ID.
+	// The function receives a copy of the stored event. The copy is stored only if the function succeeds,
+	// so no concurrent modification of the same event can be lost.
+	ModifyEvent(context.Context, string, func(*eventproto.Event) error) (*eventproto.Event, error)
+
 	// DeleteEvent deletes an existing event from the store by its ID.
 	// This function only deletes the record using the given input. No business logic there.
 	DeleteEvent(context.Context, string) error
+
+	// CreateAttendance stores the given attendance record, unless the user already checked in the event
+	// or another record has the same check-in code. Returns the stored record in all cases.
+	CreateAttendance(context.Context, *eventproto.Attendance) (*eventproto.Attendance, error)
+
+	// ListAttendances lists the attendance records of an event by its ID, in order of check-in.
+	ListAttendances(context.Context, string) ([]*eventproto.Attendance, error)
+
+	// ListUserAttendances lists the attendance records of a user by its ID.
+	ListUserAttendances(context.Context, string) ([]*eventproto.Attendance, error)
+
+	// ReadResult reads the result of an event by its ID from the store.
+	ReadResult(context.Context, string) (*eventproto.MatchResult, error)
+
+	// ModifyResult atomically applies the given function to the result of an event found by its ID,
+	// or to a new result of the event if there is none. The same way as ModifyEvent,
+	// the function receives a copy of the stored result.
+	ModifyResult(context.Context, string, func(*eventproto.MatchResult) error) (*eventproto.MatchResult, error)
+
+	// ListResults lists the results of the events with the given IDs, the events without a result are skipped.
+	ListResults(context.Context, []string) ([]*eventproto.MatchResult, error)
+
+	// CreateEventType stores the given event type, unless there is already one with the same ID.
+	CreateEventType(context.Context, *eventproto.EventType) (*eventproto.EventType, error)
+
+	// ReadEventType reads an existing event type by its ID from the store.
+	ReadEventType(context.Context, string) (*eventproto.EventType, error)
+
+	// ListEventTypes lists all event types from the store ordered by their ID.
+	ListEventTypes(context.Context) ([]*eventproto.EventType, error)
+
+	// UpdateEventType updates an existing event type in the store by its ID using the given input.
+	UpdateEventType(context.Context, string, *eventproto.EventType) (*eventproto.EventType, error)
+
+	// DeleteEventType deletes an existing event type from the store by its ID.
+	DeleteEventType(context.Context, string) error
+
+	// CreateVenue stores the given venue with a new ID.
+	CreateVenue(context.Context, *eventproto.Venue) (*eventproto.Venue, error)
+
+	// ReadVenue reads an existing venue by its ID from the store.
+	ReadVenue(context.Context, string) (*eventproto.Venue, error)
+
+	// ListVenues lists all venues from the store ordered by their name.
+	ListVenues(context.Context) ([]*eventproto.Venue, error)
+
+	// UpdateVenue updates an existing venue in the store by its ID using the given input.
+	UpdateVenue(context.Context, string, *eventproto.Venue) (*eventproto.Venue, error)
+
+	// DeleteVenue deletes an existing venue from the store by its ID.
+	DeleteVenue(context.Context, string) error
+
+	// ListVenueEvents lists the events at the venue with the given ID.
+	// The store keeps a venue index, so this function doesn't scan all events.
+	ListVenueEvents(context.Context, string) ([]*eventproto.Event, error)
+
+	// CreateVenueEvent creates a new event by the given input the same way as CreateEvent.
+	// The given function receives the events at the venue of the input and the event is created only if it succeeds.
+	// Both happen atomically, so concurrent bookings of the venue can't overlap.
+	CreateVenueEvent(context.Context, *eventproto.Event, func([]*eventproto.Event) error) (*eventproto.Event, error)
+
+	// ModifyVenueEvent atomically applies the given function to an existing event found by its ID the same way as ModifyEvent.
+	// The function also receives the events at the venue with the given ID, i.e. the venue of the modified event,
+	// so concurrent bookings of the venue can't overlap.
+	ModifyVenueEvent(context.Context, string, string, func(*eventproto.Event, []*eventproto.Event) error) (*eventproto.Event, error)
+
+	// CreateTemplate stores the given event template with a new ID.
+	CreateTemplate(context.Context, *eventproto.EventTemplate) (*eventproto.EventTemplate, error)
+
+	// ReadTemplate reads an existing event template by its ID from the store.
+	ReadTemplate(context.Context, string) (*eventproto.EventTemplate, error)
+
+	// ListTemplates lists the event templates of the owner with the given ID ordered by their name.
+	ListTemplates(context.Context, string) ([]*eventproto.EventTemplate, error)
+
+	// UpdateTemplate updates an existing event template in the store by its ID using the given input.
+	UpdateTemplate(context.Context, string, *eventproto.EventTemplate) (*eventproto.EventTemplate, error)
+
+	// DeleteTemplate deletes an existing event template from the store by its ID.
+	DeleteTemplate(context.Context, string) error
+
+	// CreateLeague stores the given league with a new ID, and a new ID for each of its teams.
+	CreateLeague(context.Context, *eventproto.League) (*eventproto.League, error)
+
+	// ReadLeague reads an existing league by its ID from the store.
+	ReadLeague(context.Context, string) (*eventproto.League, error)
+
+	// ListLeagues lists all leagues from the store ordered by their name.
+	ListLeagues(context.Context) ([]*eventproto.League, error)
+
+	// CreateSeason stores the given season with a new ID.
+	CreateSeason(context.Context, *eventproto.Season) (*eventproto.Season, error)
+
+	// ReadSeason reads an existing season by its ID from the store.
+	ReadSeason(context.Context, string) (*eventproto.Season, error)
+
+	// ModifySeason atomically applies the given function to an existing season found by its ID.
+	// The same way as ModifyEvent, the function receives a copy of the stored season.
+	ModifySeason(context.Context, string, func(*eventproto.Season) error) (*eventproto.Season, error)
+
+	// CreateSentReminder stores the given reminder record, unless the reminder with the same offset
+	// of the same occurrence is already stored. Returns true if the record was stored,
+	// so only the caller which stored it sends the reminder.
+	CreateSentReminder(context.Context, *eventproto.SentReminder) (bool, error)
+
+	// DeleteSentRemindersBefore deletes the reminder records of the occurrences starting before the given time.
+	DeleteSentRemindersBefore(context.Context, time.Time) error
+
+	// ReadCalendarFeed reads the calendar feed of a user by its ID, a feed with a new salt is stored if the user has none.
+	ReadCalendarFeed(context.Context, string) (*eventproto.CalendarFeed, error)
+
+	// RotateCalendarFeed replaces the salt of the calendar feed of a user by its ID with a new one.
+	RotateCalendarFeed(context.Context, string) (*eventproto.CalendarFeed, error)
+
+	// CreateComment stores the given comment at the end of the thread of its event.
+	// The creation times of the comments of an event are strictly increasing.
+	CreateComment(context.Context, *eventproto.Comment) (*eventproto.Comment, error)
+
+	// ReadComment reads an existing comment by its ID from the store.
+	ReadComment(context.Context, string) (*eventproto.Comment, error)
+
+	// ListComments lists the comments of an event created after the given time, oldest first.
+	// At most limit comments are listed if limit is positive.
+	ListComments(ctx context.Context, eventID string, after time.Time, limit int) ([]*eventproto.Comment, error)
+
+	// ModifyComment atomically applies the given function to an existing comment found by its ID.
+	// The same way as ModifyEvent, the function receives a copy of the stored comment.
+	ModifyComment(context.Context, string, func(*eventproto.Comment) error) (*eventproto.Comment, error)
+
+	// DeleteComment deletes an existing comment from the store by its ID.
+	DeleteComment(context.Context, string) error
 }
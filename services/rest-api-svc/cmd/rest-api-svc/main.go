@@ -1,6 +1,9 @@
 package main
 
 import (
+	// Embed the time zone database, the runtime image doesn't provide one.
+	_ "time/tzdata"
+
 	// We need these imports to register NATS broker, registry, and transport.
 	// This type is defined through evars in docker-compose.yaml file.
 	_ "github.com/micro/go-plugins/broker/nats/v2"
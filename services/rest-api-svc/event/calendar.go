@@ -0,0 +1,136 @@
+package event
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/utils/ical"
+)
+
+const (
+	// calendarProductID identifies the product which exports the calendars.
+	calendarProductID = "-//Gametime Hero//Events//EN"
+
+	// calendarName is the name of the calendar feeds of the users.
+	calendarName = "Gametime Hero"
+
+	// uidDomain makes the UIDs of the exported events globally unique.
+	uidDomain = "gametimehero"
+
+	// calendarTokenScope is signed together with the user ID, so the calendar tokens can't be used for anything else.
+	calendarTokenScope = "calendar"
+)
+
+// calendarStatuses maps the event statuses to the calendar statuses.
+var calendarStatuses = map[eventproto.Event_Status]ical.Status{
+	eventproto.Event_DRAFT:     ical.StatusTentative,
+	eventproto.Event_PUBLISHED: ical.StatusConfirmed,
+	eventproto.Event_CANCELLED: ical.StatusCancelled,
+	eventproto.Event_COMPLETED: ical.StatusConfirmed,
+}
+
+// toCalendar converts the event proto models to a calendar.
+func toCalendar(name string, events ...*eventproto.Event) *ical.Calendar {
+	calendar := &ical.Calendar{
+		ProductID: calendarProductID,
+		Name:      name,
+	}
+
+	for _, event := range events {
+		calendar.Events = append(calendar.Events, toCalendarEvents(event)...)
+	}
+
+	return calendar
+}
+
+// toCalendarEvents converts the event proto model to calendar events.
+// A recurring event is converted to the series, followed by its modified occurrences which replace
+// the original ones thanks to the shared UID. Events without a start time can't be put in a calendar.
+func toCalendarEvents(e *eventproto.Event) []ical.Event {
+	if e.GetStartTime() == nil {
+		return nil
+	}
+
+	series := toCalendarEvent(e)
+	if e.GetRecurrence() == nil {
+		return []ical.Event{series}
+	}
+
+	series.RRule = e.GetRecurrence().Rule().String()
+
+	var occurrences []ical.Event
+	for _, exception := range e.GetExceptions() {
+		originalStartTime, err := ptypes.Timestamp(exception.GetOriginalStartTime())
+		if err != nil {
+			continue
+		}
+		originalStartTime = originalStartTime.In(timeZone(e.GetTimeZone()))
+
+		if exception.GetCancelled() {
+			series.ExDates = append(series.ExDates, originalStartTime)
+			continue
+		}
+
+		occurrence := proto.Clone(e).(*eventproto.Event)
+		if exception.GetStartTime() != nil {
+			occurrence.StartTime = exception.GetStartTime()
+		} else {
+			occurrence.StartTime = exception.GetOriginalStartTime()
+		}
+		if exception.GetDuration() != nil {
+			occurrence.Duration = exception.GetDuration()
+		}
+		if exception.GetLatLong() != nil {
+			occurrence.LatLong = exception.GetLatLong()
+		}
+		if exception.GetDescription() != "" {
+			occurrence.Description = exception.GetDescription()
+		}
+
+		calendarEvent := toCalendarEvent(occurrence)
+		calendarEvent.RecurrenceID = originalStartTime
+		occurrences = append(occurrences, calendarEvent)
+	}
+
+	return append([]ical.Event{series}, occurrences...)
+}
+
+// toCalendarEvent converts the fields of the event proto model shared by all its occurrences to a calendar event.
+// The times are given in the time zone of the event, so calendar applications repeat it at the same wall clock time.
+func toCalendarEvent(e *eventproto.Event) ical.Event {
+	start, _ := ptypes.Timestamp(e.GetStartTime())
+	start = start.In(timeZone(e.GetTimeZone()))
+	updatedAt, _ := ptypes.Timestamp(e.GetUpdatedAt())
+
+	event := ical.Event{
+		UID:         e.GetId() + "@" + uidDomain,
+		Sequence:    e.GetRevision(),
+		Stamp:       updatedAt,
+		Start:       start,
+		Summary:     e.GetName(),
+		Description: e.GetDescription(),
+		Status:      calendarStatuses[e.GetStatus()],
+	}
+
+	if e.GetDuration() != nil {
+		event.End = start.Add(time.Duration(e.GetDuration().GetValue()) * time.Minute)
+	}
+
+	if l := e.GetLatLong(); l != nil {
+		event.Location = fmt.Sprintf("%g, %g", l.GetLatitude(), l.GetLongitude())
+		event.Geo = &ical.Geo{
+			Latitude:  l.GetLatitude(),
+			Longitude: l.GetLongitude(),
+		}
+	}
+
+	if e.GetCancellationReason() != "" {
+		event.Description = fmt.Sprintf("Cancelled: %s\n\n%s", e.GetCancellationReason(), event.Description)
+	}
+
+	return event
+}
@@ -0,0 +1,44 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventCommentUpdate is the handler of the comment update endpoint.
+// This func calls the comment update endpoint of event-svc with the given data.
+func (h *RestHandler) eventCommentUpdate(params operations.EventCommentUpdateParams) middleware.Responder {
+	// Call endpoint to edit an existing comment.
+	resp, err := h.commentService.UpdateComment(params.HTTPRequest.Context(), &eventproto.UpdateCommentRequest{
+		CommentId: params.CommentID.String(),
+		UserId:    toUserProto(params.Comment.Author).GetId(),
+		Text:      params.Comment.Text,
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toCommentModel(resp.GetComment())
+
+	// Return the comment model.
+	return operations.NewEventCommentUpdateOK().WithPayload(model)
+}
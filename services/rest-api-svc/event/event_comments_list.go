@@ -0,0 +1,45 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventCommentsList is the handler of the comments listing endpoint.
+// This func calls the comments listing endpoint of event-svc with the given data.
+func (h *RestHandler) eventCommentsList(params operations.EventCommentsListParams) middleware.Responder {
+	// Call endpoint to list a page of the comments of an existing event.
+	resp, err := h.commentService.ListComments(params.HTTPRequest.Context(), &eventproto.ListCommentsRequest{
+		EventId:   params.EventID.String(),
+		PageToken: params.PageToken,
+		Limit:     params.Limit,
+		ViewerId:  stringValue(params.ViewerID),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toCommentsPageModel(resp.GetData())
+
+	// Return the comments page model.
+	return operations.NewEventCommentsListOK().WithPayload(model)
+}
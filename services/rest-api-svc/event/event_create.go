@@ -15,9 +15,7 @@ import (
 func (h *RestHandler) eventCreate(params operations.EventCreateParams) middleware.Responder {
 	// Call endpoint to create a new event with the given input.
 	resp, err := h.eventService.CreateEvent(params.HTTPRequest.Context(), &eventproto.CreateEventRequest{
-		Event: &eventproto.Event{
-			Name: params.Seed.Name,
-		},
+		Event: toEventProto(params.Seed),
 	})
 	if err != nil {
 		// Handle the given error and return 500 status code.
@@ -0,0 +1,44 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventEquipmentRelease is the handler of the equipment release endpoint.
+// This func calls the equipment release endpoint of event-svc with the given data.
+func (h *RestHandler) eventEquipmentRelease(params operations.EventEquipmentReleaseParams) middleware.Responder {
+	// Call endpoint to release the claim of a user on an equipment item of an existing event.
+	resp, err := h.eventService.ReleaseEquipment(params.HTTPRequest.Context(), &eventproto.ReleaseEquipmentRequest{
+		EventId: params.EventID.String(),
+		ItemId:  params.ItemID,
+		UserId:  params.UserID,
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toEventModel(resp.GetEvent())
+
+	// Return the event model.
+	return operations.NewEventEquipmentReleaseOK().WithPayload(model)
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventExport is the handler of the event export endpoint.
+// This func calls the event reading endpoint of event-svc and renders the event as an iCalendar.
+func (h *RestHandler) eventExport(params operations.EventExportParams) middleware.Responder {
+	// Call endpoint to read an existing event by the given ID.
+	resp, err := h.eventService.ReadEvent(params.HTTPRequest.Context(), &eventproto.ReadEventRequest{
+		EventId:  params.EventID.String(),
+		ViewerId: stringValue(params.ViewerID),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the calendar.
+	calendar := toCalendar(resp.GetEvent().GetName(), resp.GetEvent())
+
+	// Return the calendar.
+	return operations.NewEventExportOK().WithPayload(calendar.String())
+}
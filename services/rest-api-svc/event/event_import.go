@@ -0,0 +1,84 @@
+package event
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// maxImportSize limits the size of imported files in bytes, so they fit in a single RPC message.
+const maxImportSize = 512 * 1024
+
+// eventImport is the handler of the events import endpoint.
+// This func calls the events import endpoint of event-svc with the uploaded file.
+func (h *RestHandler) eventImport(params operations.EventImportParams) middleware.Responder {
+	defer params.File.Close()
+
+	// Read the uploaded file.
+	data, err := ioutil.ReadAll(io.LimitReader(params.File, maxImportSize+1))
+	if err != nil {
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		})
+	} else if len(data) > maxImportSize {
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, "file is too large", http.StatusRequestEntityTooLarge)
+		})
+	}
+
+	// Prepare the import, the format is guessed from the extension of the file if not given.
+	req := &eventproto.ImportEventsRequest{
+		Format: eventproto.ImportEventsRequest_CSV,
+		Data:   data,
+	}
+	format := ""
+	if params.Format != nil {
+		format = *params.Format
+	} else if file, ok := params.File.(*runtime.File); ok {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file.Header.Filename)), ".")
+	}
+	if format == "ics" {
+		req.Format = eventproto.ImportEventsRequest_ICALENDAR
+	}
+	if params.CreatorID != nil {
+		req.Creator = &eventproto.User{
+			Id: *params.CreatorID,
+		}
+		if params.CreatorName != nil {
+			req.Creator.Name = *params.CreatorName
+		}
+	}
+
+	// Call endpoint to import events.
+	resp, err := h.eventService.ImportEvents(params.HTTPRequest.Context(), req)
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toImportReportModel(resp.GetReport())
+
+	// Return the import report model.
+	return operations.NewEventImportOK().WithPayload(model)
+}
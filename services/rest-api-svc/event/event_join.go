@@ -0,0 +1,50 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventJoin is the handler of the event joining endpoint.
+// This func calls the event joining endpoint of event-svc with the given data.
+func (h *RestHandler) eventJoin(params operations.EventJoinParams) middleware.Responder {
+	// Call endpoint to add the given user to the attendees of an existing event.
+	resp, err := h.eventService.JoinEvent(params.HTTPRequest.Context(), &eventproto.JoinEventRequest{
+		EventId: params.EventID.String(),
+		User:    toUserProto(params.User),
+		Force:   params.Force,
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetConflict() != nil {
+		// Return the clashing events with 409 status code.
+		return operations.NewEventJoinConflict().WithPayload(&models.ScheduleConflict{
+			Entries: toScheduleEntryModels(resp.GetConflict().GetEntries()),
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toEventModel(resp.GetEvent())
+
+	// Return the joined event model.
+	return operations.NewEventJoinOK().WithPayload(model)
+}
@@ -15,8 +15,28 @@ import (
 // eventsList is the handler of the events listing endpoint.
 // This func calls the events listing endpoint of event-svc.
 func (h *RestHandler) eventsList(params operations.EventsListParams) middleware.Responder {
-	// Call endpoint to list all events.
-	resp, err := h.eventService.ListEvents(params.HTTPRequest.Context(), &eventproto.ListEventsRequest{})
+	// Search events by location instead if it's given.
+	if params.Near != nil {
+		return h.eventsNear(params)
+	}
+
+	// Prepare the optional time window, the order and the limit.
+	req := &eventproto.ListEventsRequest{
+		Limit:    params.Limit,
+		ViewerId: stringValue(params.ViewerID),
+	}
+	if params.Sort == "desc" {
+		req.SortOrder = eventproto.ListEventsRequest_START_TIME_DESC
+	}
+	if params.StartAfter != nil {
+		req.StartAfter = toTimestamp(*params.StartAfter)
+	}
+	if params.StartBefore != nil {
+		req.StartBefore = toTimestamp(*params.StartBefore)
+	}
+
+	// Call endpoint to list events.
+	resp, err := h.eventService.ListEvents(params.HTTPRequest.Context(), req)
 	if err != nil {
 		// Handle the given error and return 500 status code.
 		// Also, write error message into the response.
@@ -43,3 +63,47 @@ func (h *RestHandler) eventsList(params operations.EventsListParams) middleware.
 	// Return event models.
 	return operations.NewEventsListOK().WithPayload(events)
 }
+
+// eventsNear is the handler of the events listing endpoint when a location is given.
+// This func calls the location search endpoint of event-svc.
+func (h *RestHandler) eventsNear(params operations.EventsListParams) middleware.Responder {
+	// Parse the location to search around.
+	center, err := parseLatLong(*params.Near)
+	if err != nil {
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		})
+	}
+
+	// Call endpoint to search events.
+	resp, err := h.eventService.SearchEventsNear(params.HTTPRequest.Context(), &eventproto.SearchEventsNearRequest{
+		Center:   center,
+		RadiusKm: params.RadiusKm,
+		ViewerId: stringValue(params.ViewerID),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the Swagger models.
+	events := make([]*models.Event, len(resp.GetData().GetEvents()))
+	for i, event := range resp.GetData().GetEvents() {
+		events[i] = toEventDistanceModel(event)
+	}
+
+	// Return event models.
+	return operations.NewEventsListOK().WithPayload(events)
+}
@@ -15,7 +15,8 @@ import (
 func (h *RestHandler) eventRead(params operations.EventReadParams) middleware.Responder {
 	// Call endpoint to read an existing event by the given ID.
 	resp, err := h.eventService.ReadEvent(params.HTTPRequest.Context(), &eventproto.ReadEventRequest{
-		EventId: params.EventID.String(),
+		EventId:  params.EventID.String(),
+		ViewerId: stringValue(params.ViewerID),
 	})
 	if err != nil {
 		// Handle the given error and return 500 status code.
@@ -0,0 +1,46 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventResultCorrect is the handler of the result correction endpoint.
+// This func calls the result correction endpoint of event-svc with the given data.
+func (h *RestHandler) eventResultCorrect(params operations.EventResultCorrectParams) middleware.Responder {
+	// Call endpoint to correct the result of an existing event.
+	resp, err := h.eventService.CorrectResult(params.HTTPRequest.Context(), &eventproto.CorrectResultRequest{
+		EventId:     params.EventID.String(),
+		OrganizerId: params.Correction.OrganizerID,
+		Reason:      params.Correction.Reason,
+		Scores:      toTeamScoreProtos(params.Correction.Scores),
+		PlayerStats: toPlayerStatsProtos(params.Correction.PlayerStats),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toMatchResultModel(resp.GetMatchResult())
+
+	// Return the result model.
+	return operations.NewEventResultCorrectOK().WithPayload(model)
+}
@@ -0,0 +1,44 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// eventTypesList is the handler of the event types listing endpoint.
+// This func calls the event types listing endpoint of event-svc with the given data.
+func (h *RestHandler) eventTypesList(params operations.EventTypesListParams) middleware.Responder {
+	// Call endpoint to list the event type catalog.
+	resp, err := h.eventService.ListEventTypes(params.HTTPRequest.Context(), &eventproto.ListEventTypesRequest{})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the Swagger models.
+	eventTypes := make([]*models.EventType, len(resp.GetData().GetEventTypes()))
+	for i, eventType := range resp.GetData().GetEventTypes() {
+		eventTypes[i] = toEventTypeModel(eventType)
+	}
+
+	// Return event type models.
+	return operations.NewEventTypesListOK().WithPayload(eventTypes)
+}
@@ -16,9 +16,7 @@ func (h *RestHandler) eventUpdate(params operations.EventUpdateParams) middlewar
 	// Call endpoint to update an existing event with the given input.
 	resp, err := h.eventService.UpdateEvent(params.HTTPRequest.Context(), &eventproto.UpdateEventRequest{
 		EventId: params.EventID.String(),
-		Event: &eventproto.Event{
-			Name: params.Seed.Name,
-		},
+		Event:   toEventProto(params.Seed),
 	})
 	if err != nil {
 		// Handle the given error and return 500 status code.
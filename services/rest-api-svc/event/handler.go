@@ -10,29 +10,95 @@ import (
 // RestHandlerOptions contains required options for the handler.
 // This handler implements REST endpoints with handling incoming data.
 type RestHandlerOptions struct {
-	EventService eventproto.EventService
-	Logger       logrus.FieldLogger
+	EventService   eventproto.EventService
+	CommentService eventproto.CommentService
+	Logger         logrus.FieldLogger
+
+	// CalendarSecret signs the tokens of the calendar feeds.
+	CalendarSecret string
 }
 
 // RestHandler defines the REST interface for the business service.
 type RestHandler struct {
-	eventService eventproto.EventService
-	logger       logrus.FieldLogger
+	eventService   eventproto.EventService
+	commentService eventproto.CommentService
+	logger         logrus.FieldLogger
+	calendarSecret string
 }
 
 // NewRestHandler creates a new Handler.
 func NewRestHandler(opts *RestHandlerOptions) *RestHandler {
 	return &RestHandler{
-		eventService: opts.EventService,
-		logger:       opts.Logger,
+		eventService:   opts.EventService,
+		commentService: opts.CommentService,
+		logger:         opts.Logger,
+		calendarSecret: opts.CalendarSecret,
 	}
 }
 
 // Register registers endpoints to the handler.
 func (h *RestHandler) Register(api *operations.RestAPISvcAPI) {
+	api.EventTypeCreateHandler = operations.EventTypeCreateHandlerFunc(h.eventTypeCreate)
+	api.EventTypeReadHandler = operations.EventTypeReadHandlerFunc(h.eventTypeRead)
+	api.EventTypesListHandler = operations.EventTypesListHandlerFunc(h.eventTypesList)
+	api.EventTypeUpdateHandler = operations.EventTypeUpdateHandlerFunc(h.eventTypeUpdate)
+	api.EventTypeDeleteHandler = operations.EventTypeDeleteHandlerFunc(h.eventTypeDelete)
+	api.VenueCreateHandler = operations.VenueCreateHandlerFunc(h.venueCreate)
+	api.VenueReadHandler = operations.VenueReadHandlerFunc(h.venueRead)
+	api.VenuesListHandler = operations.VenuesListHandlerFunc(h.venuesList)
+	api.VenueUpdateHandler = operations.VenueUpdateHandlerFunc(h.venueUpdate)
+	api.VenueDeleteHandler = operations.VenueDeleteHandlerFunc(h.venueDelete)
+	api.TemplateCreateHandler = operations.TemplateCreateHandlerFunc(h.templateCreate)
+	api.TemplateReadHandler = operations.TemplateReadHandlerFunc(h.templateRead)
+	api.TemplatesListHandler = operations.TemplatesListHandlerFunc(h.templatesList)
+	api.TemplateUpdateHandler = operations.TemplateUpdateHandlerFunc(h.templateUpdate)
+	api.TemplateDeleteHandler = operations.TemplateDeleteHandlerFunc(h.templateDelete)
+	api.TemplateEventCreateHandler = operations.TemplateEventCreateHandlerFunc(h.templateEventCreate)
+	api.LeagueCreateHandler = operations.LeagueCreateHandlerFunc(h.leagueCreate)
+	api.LeagueReadHandler = operations.LeagueReadHandlerFunc(h.leagueRead)
+	api.LeaguesListHandler = operations.LeaguesListHandlerFunc(h.leaguesList)
+	api.SeasonCreateHandler = operations.SeasonCreateHandlerFunc(h.seasonCreate)
+	api.SeasonReadHandler = operations.SeasonReadHandlerFunc(h.seasonRead)
+	api.SeasonFixturesGenerateHandler = operations.SeasonFixturesGenerateHandlerFunc(h.seasonFixturesGenerate)
+	api.SeasonStandingsReadHandler = operations.SeasonStandingsReadHandlerFunc(h.seasonStandingsRead)
 	api.EventCreateHandler = operations.EventCreateHandlerFunc(h.eventCreate)
 	api.EventReadHandler = operations.EventReadHandlerFunc(h.eventRead)
 	api.EventsListHandler = operations.EventsListHandlerFunc(h.eventsList)
+	api.EventsSearchHandler = operations.EventsSearchHandlerFunc(h.eventsSearch)
 	api.EventUpdateHandler = operations.EventUpdateHandlerFunc(h.eventUpdate)
 	api.EventDeleteHandler = operations.EventDeleteHandlerFunc(h.eventDelete)
+	api.EventImportHandler = operations.EventImportHandlerFunc(h.eventImport)
+	api.EventCloneHandler = operations.EventCloneHandlerFunc(h.eventClone)
+	api.EventJoinHandler = operations.EventJoinHandlerFunc(h.eventJoin)
+	api.EventLeaveHandler = operations.EventLeaveHandlerFunc(h.eventLeave)
+	api.EventInviteHandler = operations.EventInviteHandlerFunc(h.eventInvite)
+	api.EventInviteLinkCreateHandler = operations.EventInviteLinkCreateHandlerFunc(h.eventInviteLinkCreate)
+	api.EventInvitationAcceptHandler = operations.EventInvitationAcceptHandlerFunc(h.eventInvitationAccept)
+	api.EventInvitationDeclineHandler = operations.EventInvitationDeclineHandlerFunc(h.eventInvitationDecline)
+	api.EventWaitlistReadHandler = operations.EventWaitlistReadHandlerFunc(h.eventWaitlistRead)
+	api.EventOccurrenceUpdateHandler = operations.EventOccurrenceUpdateHandlerFunc(h.eventOccurrenceUpdate)
+	api.EventOccurrenceCancelHandler = operations.EventOccurrenceCancelHandlerFunc(h.eventOccurrenceCancel)
+	api.EventPublishHandler = operations.EventPublishHandlerFunc(h.eventPublish)
+	api.EventCancelHandler = operations.EventCancelHandlerFunc(h.eventCancel)
+	api.EventCompleteHandler = operations.EventCompleteHandlerFunc(h.eventComplete)
+	api.EventEquipmentClaimHandler = operations.EventEquipmentClaimHandlerFunc(h.eventEquipmentClaim)
+	api.EventEquipmentReleaseHandler = operations.EventEquipmentReleaseHandlerFunc(h.eventEquipmentRelease)
+	api.EventTeamsGenerateHandler = operations.EventTeamsGenerateHandlerFunc(h.eventTeamsGenerate)
+	api.EventResultReadHandler = operations.EventResultReadHandlerFunc(h.eventResultRead)
+	api.EventResultRecordHandler = operations.EventResultRecordHandlerFunc(h.eventResultRecord)
+	api.EventResultCorrectHandler = operations.EventResultCorrectHandlerFunc(h.eventResultCorrect)
+	api.EventCommentsListHandler = operations.EventCommentsListHandlerFunc(h.eventCommentsList)
+	api.EventCommentCreateHandler = operations.EventCommentCreateHandlerFunc(h.eventCommentCreate)
+	api.EventCommentUpdateHandler = operations.EventCommentUpdateHandlerFunc(h.eventCommentUpdate)
+	api.EventCommentDeleteHandler = operations.EventCommentDeleteHandlerFunc(h.eventCommentDelete)
+	api.EventCheckInCodeCreateHandler = operations.EventCheckInCodeCreateHandlerFunc(h.eventCheckInCodeCreate)
+	api.EventCheckInHandler = operations.EventCheckInHandlerFunc(h.eventCheckIn)
+	api.EventAttendanceListHandler = operations.EventAttendanceListHandlerFunc(h.eventAttendanceList)
+	api.UserAttendanceReadHandler = operations.UserAttendanceReadHandlerFunc(h.userAttendanceRead)
+	api.UserScheduleReadHandler = operations.UserScheduleReadHandlerFunc(h.userScheduleRead)
+	api.UserInvitationsListHandler = operations.UserInvitationsListHandlerFunc(h.userInvitationsList)
+	api.EventExportHandler = operations.EventExportHandlerFunc(h.eventExport)
+	api.UserCalendarFeedReadHandler = operations.UserCalendarFeedReadHandlerFunc(h.userCalendarFeedRead)
+	api.UserCalendarFeedRotateHandler = operations.UserCalendarFeedRotateHandlerFunc(h.userCalendarFeedRotate)
+	api.UserCalendarExportHandler = operations.UserCalendarExportHandlerFunc(h.userCalendarExport)
 }
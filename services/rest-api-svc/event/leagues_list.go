@@ -0,0 +1,44 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// leaguesList is the handler of the leagues listing endpoint.
+// This func calls the leagues listing endpoint of event-svc with the given data.
+func (h *RestHandler) leaguesList(params operations.LeaguesListParams) middleware.Responder {
+	// Call endpoint to list the leagues.
+	resp, err := h.eventService.ListLeagues(params.HTTPRequest.Context(), &eventproto.ListLeaguesRequest{})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the Swagger models.
+	leagues := make([]*models.League, len(resp.GetData().GetLeagues()))
+	for i, league := range resp.GetData().GetLeagues() {
+		leagues[i] = toLeagueModel(league)
+	}
+
+	// Return league models.
+	return operations.NewLeaguesListOK().WithPayload(leagues)
+}
@@ -1,22 +1,938 @@
 package event
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
 
+	"github.com/marboga/gametimehero/proto/common"
 	eventproto "github.com/marboga/gametimehero/proto/event-svc"
 	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
 )
 
+// weekdays contains the RRULE codes of weekdays indexed by eventproto.Weekday.
+var weekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// frequencies maps the recurrence frequencies to their Swagger names.
+var frequencies = map[eventproto.Recurrence_Frequency]string{
+	eventproto.Recurrence_DAILY:   "daily",
+	eventproto.Recurrence_WEEKLY:  "weekly",
+	eventproto.Recurrence_MONTHLY: "monthly",
+}
+
+// statuses maps the event statuses to their Swagger names.
+var statuses = map[eventproto.Event_Status]string{
+	eventproto.Event_DRAFT:     "draft",
+	eventproto.Event_PUBLISHED: "published",
+	eventproto.Event_CANCELLED: "cancelled",
+	eventproto.Event_COMPLETED: "completed",
+}
+
+// visibilities maps the event visibilities to their Swagger names.
+var visibilities = map[eventproto.Event_Visibility]string{
+	eventproto.Event_PUBLIC:   "public",
+	eventproto.Event_UNLISTED: "unlisted",
+	eventproto.Event_PRIVATE:  "private",
+}
+
+// invitationStatuses maps the invitation statuses to their Swagger names.
+var invitationStatuses = map[eventproto.Invitation_Status]string{
+	eventproto.Invitation_PENDING:  "pending",
+	eventproto.Invitation_ACCEPTED: "accepted",
+	eventproto.Invitation_DECLINED: "declined",
+}
+
+// importResults maps the import results to their Swagger names.
+var importResults = map[eventproto.ImportRow_Result]string{
+	eventproto.ImportRow_CREATED: "created",
+	eventproto.ImportRow_SKIPPED: "skipped",
+	eventproto.ImportRow_FAILED:  "failed",
+}
+
+// checkInMethods maps the check-in methods to their Swagger names.
+var checkInMethods = map[eventproto.Attendance_Method]string{
+	eventproto.Attendance_ORGANIZER: "organizer",
+	eventproto.Attendance_CODE:      "code",
+}
+
+// roles maps the roles of the users on their schedule to their Swagger names.
+var roles = map[eventproto.ScheduleEntry_Role]string{
+	eventproto.ScheduleEntry_ATTENDEE:   "attendee",
+	eventproto.ScheduleEntry_ORGANIZER:  "organizer",
+	eventproto.ScheduleEntry_WAITLISTED: "waitlisted",
+}
+
 // toEventModel converts the event proto model to the Swagger model.
 func toEventModel(u *eventproto.Event) *models.Event {
 	updatedAt, _ := ptypes.Timestamp(u.GetUpdatedAt())
 	createdAt, _ := ptypes.Timestamp(u.GetCreatedAt())
 
-	return &models.Event{
-		ID:        u.GetId(),
-		Name:      u.GetName(),
-		UpdatedAt: strfmt.DateTime(updatedAt),
-		CreatedAt: strfmt.DateTime(createdAt),
+	model := &models.Event{
+		ID:                 u.GetId(),
+		Name:               u.GetName(),
+		UpdatedAt:          strfmt.DateTime(updatedAt),
+		CreatedAt:          strfmt.DateTime(createdAt),
+		EventType:          u.GetEventType(),
+		LatLong:            toLatLongModel(u.GetLatLong()),
+		StartTime:          toDateTime(u.GetStartTime()),
+		LocalStartTime:     toLocalTime(u.GetStartTime(), u.GetTimeZone()),
+		TimeZone:           u.GetTimeZone(),
+		Duration:           u.GetDuration().GetValue(),
+		Creator:            toUserRefModel(u.GetCreator()),
+		Attendees:          make([]*models.UserRef, len(u.GetAttendees())),
+		IconURL:            u.GetIconUrl(),
+		Description:        u.GetDescription(),
+		AttendeeCount:      u.GetAttendeeCount().GetValue(),
+		Equipment:          make([]*models.EquipmentItem, len(u.GetEquipment())),
+		Waitlist:           make([]*models.UserRef, len(u.GetWaitlist())),
+		Recurrence:         toRecurrenceModel(u.GetRecurrence()),
+		Exceptions:         make([]*models.OccurrenceException, len(u.GetExceptions())),
+		OriginalStartTime:  toDateTime(u.GetOriginalStartTime()),
+		Status:             statuses[u.GetStatus()],
+		CancellationReason: u.GetCancellationReason(),
+		Revision:           u.GetRevision(),
+		PlayerSkills:       u.GetPlayerSkills(),
+		Lineup:             toLineupModel(u.GetLineup()),
+		VenueID:            u.GetVenueId(),
+		Visibility:         visibilities[u.GetVisibility()],
+		Invitations:        toInvitationModels(u.GetInvitations()),
+		DecisionDeadline:   toDateTime(u.GetDecisionDeadline()),
+		ConfirmedAt:        toDateTime(u.GetConfirmedAt()),
+	}
+
+	if u.GetMaxAttendees() != nil {
+		maxAttendees := u.GetMaxAttendees().GetValue()
+		model.MaxAttendees = &maxAttendees
+	}
+
+	if u.GetMinAttendees() != nil {
+		minAttendees := u.GetMinAttendees().GetValue()
+		model.MinAttendees = &minAttendees
+	}
+
+	for i, attendee := range u.GetAttendees() {
+		model.Attendees[i] = toUserRefModel(attendee)
+	}
+
+	for i, user := range u.GetWaitlist() {
+		model.Waitlist[i] = toUserRefModel(user)
+	}
+
+	for i, item := range u.GetEquipment() {
+		model.Equipment[i] = toEquipmentItemModel(item)
+	}
+
+	for i, exception := range u.GetExceptions() {
+		model.Exceptions[i] = toOccurrenceExceptionModel(exception)
+	}
+
+	return model
+}
+
+// toEventDistanceModel converts the event found by a location search to the Swagger model.
+func toEventDistanceModel(d *eventproto.EventDistance) *models.Event {
+	model := toEventModel(d.GetEvent())
+
+	distanceKm := d.GetDistanceKm()
+	model.DistanceKm = &distanceKm
+
+	return model
+}
+
+// toEventMatchModel converts the event found by a text search to the Swagger model.
+func toEventMatchModel(m *eventproto.EventMatch) *models.Event {
+	model := toEventModel(m.GetEvent())
+
+	score := m.GetScore()
+	model.Score = &score
+
+	return model
+}
+
+// toEquipmentItemModel converts the equipment item proto model to the Swagger model.
+// The uncovered quantity is derived from the claims.
+func toEquipmentItemModel(e *eventproto.EquipmentItem) *models.EquipmentItem {
+	model := &models.EquipmentItem{
+		ID:        e.GetId(),
+		Name:      e.GetName(),
+		Quantity:  e.GetQuantity(),
+		Claims:    make([]*models.EquipmentClaim, len(e.GetClaims())),
+		Uncovered: e.GetQuantity(),
+	}
+
+	if model.Uncovered == 0 {
+		model.Uncovered = 1
+	}
+
+	for i, claim := range e.GetClaims() {
+		model.Claims[i] = &models.EquipmentClaim{
+			User:     toUserRefModel(claim.GetUser()),
+			Quantity: claim.GetQuantity(),
+		}
+		model.Uncovered -= claim.GetQuantity()
+	}
+
+	if model.Uncovered < 0 {
+		model.Uncovered = 0
+	}
+
+	return model
+}
+
+// toLineupModel converts the lineup proto model to the Swagger model.
+func toLineupModel(l *eventproto.Lineup) *models.Lineup {
+	if l == nil {
+		return nil
+	}
+
+	model := &models.Lineup{
+		Teams:        make([]*models.Team, len(l.GetTeams())),
+		GeneratedAt:  toDateTime(l.GetGeneratedAt()),
+		DefaultSkill: l.GetDefaultSkill(),
+	}
+
+	for i, team := range l.GetTeams() {
+		model.Teams[i] = &models.Team{
+			Name:       team.GetName(),
+			Players:    make([]*models.UserRef, len(team.GetPlayers())),
+			TotalSkill: team.GetTotalSkill(),
+		}
+
+		for j, player := range team.GetPlayers() {
+			model.Teams[i].Players[j] = toUserRefModel(player)
+		}
+	}
+
+	return model
+}
+
+// toMatchResultModel converts the match result proto model to the Swagger model.
+func toMatchResultModel(r *eventproto.MatchResult) *models.MatchResult {
+	model := &models.MatchResult{
+		EventID:     r.GetEventId(),
+		Scores:      toTeamScoreModels(r.GetScores()),
+		PlayerStats: toPlayerStatsModels(r.GetPlayerStats()),
+		RecordedBy:  r.GetRecordedBy(),
+		RecordedAt:  toDateTime(r.GetRecordedAt()),
+		UpdatedAt:   toDateTime(r.GetUpdatedAt()),
+		ConfirmedAt: toDateTime(r.GetConfirmedAt()),
+		Corrections: make([]*models.ResultCorrection, len(r.GetCorrections())),
+	}
+
+	for i, correction := range r.GetCorrections() {
+		model.Corrections[i] = &models.ResultCorrection{
+			CorrectedBy:         correction.GetCorrectedBy(),
+			CorrectedAt:         toDateTime(correction.GetCorrectedAt()),
+			Reason:              correction.GetReason(),
+			PreviousScores:      toTeamScoreModels(correction.GetPreviousScores()),
+			PreviousPlayerStats: toPlayerStatsModels(correction.GetPreviousPlayerStats()),
+		}
+	}
+
+	return model
+}
+
+// toTeamScoreModels converts the team score proto models to the Swagger models.
+func toTeamScoreModels(scores []*eventproto.TeamScore) []*models.TeamScore {
+	result := make([]*models.TeamScore, len(scores))
+	for i, score := range scores {
+		result[i] = &models.TeamScore{
+			Team:  score.GetTeam(),
+			Score: score.GetScore(),
+		}
+	}
+
+	return result
+}
+
+// toPlayerStatsModels converts the player statistics proto models to the Swagger models.
+func toPlayerStatsModels(playerStats []*eventproto.PlayerStats) []*models.PlayerStats {
+	result := make([]*models.PlayerStats, len(playerStats))
+	for i, stats := range playerStats {
+		result[i] = &models.PlayerStats{
+			User:  toUserRefModel(stats.GetUser()),
+			Stats: stats.GetStats(),
+		}
+	}
+
+	return result
+}
+
+// toUserRefModel converts the user reference proto model to the Swagger model.
+func toUserRefModel(u *eventproto.User) *models.UserRef {
+	if u == nil {
+		return nil
+	}
+
+	return &models.UserRef{
+		ID:   u.GetId(),
+		Name: u.GetName(),
+	}
+}
+
+// toLatLongModel converts the location proto model to the Swagger model.
+func toLatLongModel(l *eventproto.LatLong) *models.LatLong {
+	if l == nil {
+		return nil
+	}
+
+	return &models.LatLong{
+		Latitude:  l.GetLatitude(),
+		Longitude: l.GetLongitude(),
+	}
+}
+
+// toRecurrenceModel converts the recurrence proto model to the Swagger model.
+func toRecurrenceModel(r *eventproto.Recurrence) *models.Recurrence {
+	if r == nil {
+		return nil
+	}
+
+	model := &models.Recurrence{
+		Frequency: frequencies[r.GetFrequency()],
+		Interval:  r.GetInterval(),
+		ByDay:     make([]string, len(r.GetByDay())),
+		Count:     r.GetCount(),
+		Until:     toDateTime(r.GetUntil()),
+	}
+
+	for i, day := range r.GetByDay() {
+		model.ByDay[i] = weekdays[day]
+	}
+
+	return model
+}
+
+// toOccurrenceExceptionModel converts the occurrence exception proto model to the Swagger model.
+func toOccurrenceExceptionModel(e *eventproto.OccurrenceException) *models.OccurrenceException {
+	return &models.OccurrenceException{
+		OriginalStartTime: toDateTime(e.GetOriginalStartTime()),
+		Cancelled:         e.GetCancelled(),
+		StartTime:         toDateTime(e.GetStartTime()),
+		Duration:          e.GetDuration().GetValue(),
+		LatLong:           toLatLongModel(e.GetLatLong()),
+		Description:       e.GetDescription(),
+	}
+}
+
+// toWaitlistPositionModel converts the waitlist position proto model to the Swagger model.
+func toWaitlistPositionModel(p *eventproto.WaitlistPosition) *models.WaitlistPosition {
+	return &models.WaitlistPosition{
+		EventID:  p.GetEventId(),
+		UserID:   p.GetUserId(),
+		Position: p.GetPosition(),
+	}
+}
+
+// toImportReportModel converts the import report proto model to the Swagger model.
+func toImportReportModel(r *eventproto.ImportReport) *models.ImportReport {
+	model := &models.ImportReport{
+		Created: r.GetCreated(),
+		Skipped: r.GetSkipped(),
+		Failed:  r.GetFailed(),
+		Rows:    make([]*models.ImportRow, len(r.GetRows())),
+	}
+
+	for i, row := range r.GetRows() {
+		model.Rows[i] = &models.ImportRow{
+			Number:  row.GetNumber(),
+			Result:  importResults[row.GetResult()],
+			EventID: row.GetEventId(),
+			Message: row.GetMessage(),
+		}
+	}
+
+	return model
+}
+
+// toCheckInCodeModel converts the check-in code proto model to the Swagger model.
+func toCheckInCodeModel(c *eventproto.CheckInCode) *models.CheckInCode {
+	return &models.CheckInCode{
+		EventID:   c.GetEventId(),
+		Code:      c.GetCode(),
+		ExpiresAt: toDateTime(c.GetExpiresAt()),
+	}
+}
+
+// toInviteLinkModel converts the invite link proto model to the Swagger model.
+func toInviteLinkModel(l *eventproto.InviteLink) *models.InviteLink {
+	return &models.InviteLink{
+		EventID:   l.GetEventId(),
+		Token:     l.GetToken(),
+		ExpiresAt: toDateTime(l.GetExpiresAt()),
+	}
+}
+
+// toAttendanceModel converts the attendance proto model to the Swagger model.
+func toAttendanceModel(a *eventproto.Attendance) *models.Attendance {
+	return &models.Attendance{
+		EventID:     a.GetEventId(),
+		User:        toUserRefModel(a.GetUser()),
+		CheckedInAt: toDateTime(a.GetCheckedInAt()),
+		Method:      checkInMethods[a.GetMethod()],
+	}
+}
+
+// toAttendanceReportModel converts the attendance report proto model to the Swagger model.
+func toAttendanceReportModel(r *eventproto.AttendanceReport) *models.AttendanceReport {
+	model := &models.AttendanceReport{
+		EventID:     r.GetEventId(),
+		Attendances: make([]*models.Attendance, len(r.GetAttendances())),
+		NoShows:     make([]*models.UserRef, len(r.GetNoShows())),
+	}
+
+	for i, attendance := range r.GetAttendances() {
+		model.Attendances[i] = toAttendanceModel(attendance)
+	}
+
+	for i, user := range r.GetNoShows() {
+		model.NoShows[i] = toUserRefModel(user)
+	}
+
+	return model
+}
+
+// toUserAttendanceModel converts the user attendance proto model to the Swagger model.
+func toUserAttendanceModel(a *eventproto.UserAttendance) *models.UserAttendance {
+	return &models.UserAttendance{
+		UserID:   a.GetUserId(),
+		Attended: a.GetAttended(),
+		NoShows:  a.GetNoShows(),
+	}
+}
+
+// toEventTypeModel converts the event type proto model to the Swagger model.
+func toEventTypeModel(t *eventproto.EventType) *models.EventType {
+	model := &models.EventType{
+		ID:                t.GetId(),
+		DisplayName:       t.GetDisplayName(),
+		IconURL:           t.GetIconUrl(),
+		MinPlayers:        t.GetMinPlayers(),
+		MaxPlayers:        t.GetMaxPlayers(),
+		DefaultDuration:   t.GetDefaultDuration(),
+		EquipmentTemplate: make([]*models.EquipmentItem, len(t.GetEquipmentTemplate())),
+		CreatedAt:         toDateTime(t.GetCreatedAt()),
+		UpdatedAt:         toDateTime(t.GetUpdatedAt()),
+	}
+
+	for i, item := range t.GetEquipmentTemplate() {
+		model.EquipmentTemplate[i] = &models.EquipmentItem{
+			Name:     item.GetName(),
+			Quantity: item.GetQuantity(),
+		}
+	}
+
+	return model
+}
+
+// toInvitationModels converts the invitation proto models to the Swagger models.
+func toInvitationModels(invitations []*eventproto.Invitation) []*models.Invitation {
+	result := make([]*models.Invitation, len(invitations))
+	for i, invitation := range invitations {
+		result[i] = &models.Invitation{
+			EventID:     invitation.GetEventId(),
+			UserID:      invitation.GetUserId(),
+			Status:      invitationStatuses[invitation.GetStatus()],
+			InvitedBy:   invitation.GetInvitedBy(),
+			CreatedAt:   toDateTime(invitation.GetCreatedAt()),
+			RespondedAt: toDateTime(invitation.GetRespondedAt()),
+		}
+	}
+
+	return result
+}
+
+// toScheduleEntryModels converts the schedule entry proto models to the Swagger models.
+func toScheduleEntryModels(entries []*eventproto.ScheduleEntry) []*models.ScheduleEntry {
+	result := make([]*models.ScheduleEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = &models.ScheduleEntry{
+			Event:       toEventModel(entry.GetEvent()),
+			Role:        roles[entry.GetRole()],
+			EndTime:     toDateTime(entry.GetEndTime()),
+			Overlapping: entry.GetOverlapping(),
+		}
+	}
+
+	return result
+}
+
+// toVenueModel converts the venue proto model to the Swagger model.
+func toVenueModel(v *eventproto.Venue) *models.Venue {
+	model := &models.Venue{
+		ID:           v.GetId(),
+		Name:         v.GetName(),
+		Address:      v.GetAddress(),
+		LatLong:      toLatLongModel(v.GetLatLong()),
+		Capacity:     v.GetCapacity(),
+		Surface:      v.GetSurface(),
+		Amenities:    v.GetAmenities(),
+		OpeningHours: make([]*models.OpeningHours, len(v.GetOpeningHours())),
+		TimeZone:     v.GetTimeZone(),
+		CreatedAt:    toDateTime(v.GetCreatedAt()),
+		UpdatedAt:    toDateTime(v.GetUpdatedAt()),
+	}
+
+	for i, hours := range v.GetOpeningHours() {
+		model.OpeningHours[i] = &models.OpeningHours{
+			Day:    weekdays[hours.GetDay()],
+			Opens:  hours.GetOpens(),
+			Closes: hours.GetCloses(),
+		}
+	}
+
+	return model
+}
+
+// toTemplateModel converts the event template proto model to the Swagger model.
+func toTemplateModel(t *eventproto.EventTemplate) *models.EventTemplate {
+	return &models.EventTemplate{
+		ID:        t.GetId(),
+		Name:      t.GetName(),
+		OwnerID:   t.GetOwnerId(),
+		Event:     toEventModel(t.GetEvent()),
+		CreatedAt: toDateTime(t.GetCreatedAt()),
+		UpdatedAt: toDateTime(t.GetUpdatedAt()),
+	}
+}
+
+// toLeagueModel converts the league proto model to the Swagger model.
+func toLeagueModel(l *eventproto.League) *models.League {
+	model := &models.League{
+		ID:          l.GetId(),
+		Name:        l.GetName(),
+		EventType:   l.GetEventType(),
+		OrganizerID: l.GetOrganizerId(),
+		Teams:       make([]*models.LeagueTeam, len(l.GetTeams())),
+		CreatedAt:   toDateTime(l.GetCreatedAt()),
+		UpdatedAt:   toDateTime(l.GetUpdatedAt()),
+	}
+
+	for i, team := range l.GetTeams() {
+		model.Teams[i] = toLeagueTeamModel(team)
+	}
+
+	return model
+}
+
+// toLeagueTeamModel converts the league team proto model to the Swagger model.
+func toLeagueTeamModel(t *eventproto.LeagueTeam) *models.LeagueTeam {
+	return &models.LeagueTeam{
+		ID:   t.GetId(),
+		Name: t.GetName(),
+	}
+}
+
+// toSeasonModel converts the season proto model to the Swagger model.
+func toSeasonModel(s *eventproto.Season) *models.Season {
+	model := &models.Season{
+		ID:        s.GetId(),
+		LeagueID:  s.GetLeagueId(),
+		Name:      s.GetName(),
+		TeamIds:   s.GetTeamIds(),
+		Fixtures:  make([]*models.Fixture, len(s.GetFixtures())),
+		CreatedAt: toDateTime(s.GetCreatedAt()),
+		UpdatedAt: toDateTime(s.GetUpdatedAt()),
+	}
+
+	for i, fixture := range s.GetFixtures() {
+		model.Fixtures[i] = &models.Fixture{
+			EventID:    fixture.GetEventId(),
+			Round:      fixture.GetRound(),
+			HomeTeamID: fixture.GetHomeTeamId(),
+			AwayTeamID: fixture.GetAwayTeamId(),
+		}
+	}
+
+	return model
+}
+
+// toStandingModel converts the standing proto model to the Swagger model.
+func toStandingModel(s *eventproto.Standing) *models.Standing {
+	return &models.Standing{
+		Position:       s.GetPosition(),
+		Team:           toLeagueTeamModel(s.GetTeam()),
+		Played:         s.GetPlayed(),
+		Won:            s.GetWon(),
+		Drawn:          s.GetDrawn(),
+		Lost:           s.GetLost(),
+		GoalsFor:       s.GetGoalsFor(),
+		GoalsAgainst:   s.GetGoalsAgainst(),
+		GoalDifference: s.GetGoalDifference(),
+		Points:         s.GetPoints(),
+	}
+}
+
+// toCommentModel converts the comment proto model to the Swagger model.
+func toCommentModel(c *eventproto.Comment) *models.Comment {
+	return &models.Comment{
+		ID:        c.GetId(),
+		EventID:   c.GetEventId(),
+		Author:    toUserRefModel(c.GetAuthor()),
+		Text:      c.GetText(),
+		CreatedAt: toDateTime(c.GetCreatedAt()),
+		UpdatedAt: toDateTime(c.GetUpdatedAt()),
+		Edited:    c.GetEdited(),
+	}
+}
+
+// toCommentsPageModel converts the comments page proto model to the Swagger model.
+func toCommentsPageModel(p *eventproto.ListCommentsResponseOK) *models.CommentsPage {
+	model := &models.CommentsPage{
+		Comments:      make([]*models.Comment, len(p.GetComments())),
+		NextPageToken: p.GetNextPageToken(),
+	}
+
+	for i, comment := range p.GetComments() {
+		model.Comments[i] = toCommentModel(comment)
+	}
+
+	return model
+}
+
+// toLocalTime formats the timestamp proto model in the given time zone in the RFC 3339 format.
+// Unset timestamps are converted to an empty string.
+func toLocalTime(ts *timestamp.Timestamp, zone string) string {
+	if ts == nil {
+		return ""
+	}
+
+	t, _ := ptypes.Timestamp(ts)
+	return t.In(timeZone(zone)).Format(time.RFC3339)
+}
+
+// timeZone returns the IANA time zone with the given name, UTC if it's not set or unknown.
+func timeZone(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// stringValue returns the value of the given optional parameter, or an empty string if it isn't set.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// toDateTime converts the timestamp proto model to the Swagger date-time.
+// Unset timestamps are converted to the zero date-time.
+func toDateTime(ts *timestamp.Timestamp) strfmt.DateTime {
+	if ts == nil {
+		return strfmt.DateTime{}
+	}
+
+	t, _ := ptypes.Timestamp(ts)
+	return strfmt.DateTime(t)
+}
+
+// toEventProto converts the event Swagger model to the proto model.
+// Read-only fields like ID, timestamps, the attendee count, the waitlist and the equipment claims are ignored.
+func toEventProto(m *models.Event) *eventproto.Event {
+	event := &eventproto.Event{
+		Name:             m.Name,
+		EventType:        m.EventType,
+		LatLong:          toLatLongProto(m.LatLong),
+		StartTime:        toTimestamp(m.StartTime),
+		Duration:         toInt64Proto(m.Duration),
+		Creator:          toUserProto(m.Creator),
+		Attendees:        make([]*eventproto.User, len(m.Attendees)),
+		IconUrl:          m.IconURL,
+		Description:      m.Description,
+		Equipment:        make([]*eventproto.EquipmentItem, len(m.Equipment)),
+		Recurrence:       toRecurrenceProto(m.Recurrence),
+		PlayerSkills:     m.PlayerSkills,
+		VenueId:          m.VenueID,
+		TimeZone:         m.TimeZone,
+		DecisionDeadline: toTimestamp(m.DecisionDeadline),
+	}
+
+	for visibility, name := range visibilities {
+		if name == m.Visibility {
+			event.Visibility = visibility
+		}
+	}
+
+	if m.MaxAttendees != nil {
+		event.MaxAttendees = &common.Int64{
+			Value: *m.MaxAttendees,
+		}
+	}
+
+	if m.MinAttendees != nil {
+		event.MinAttendees = &common.Int64{
+			Value: *m.MinAttendees,
+		}
+	}
+
+	for i, attendee := range m.Attendees {
+		event.Attendees[i] = toUserProto(attendee)
+	}
+
+	for i, item := range m.Equipment {
+		event.Equipment[i] = &eventproto.EquipmentItem{
+			Id:       item.ID,
+			Name:     item.Name,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return event
+}
+
+// toEventTypeProto converts the event type Swagger model to the proto model.
+// Read-only fields like timestamps are ignored.
+func toEventTypeProto(m *models.EventType) *eventproto.EventType {
+	eventType := &eventproto.EventType{
+		Id:                m.ID,
+		DisplayName:       m.DisplayName,
+		IconUrl:           m.IconURL,
+		MinPlayers:        m.MinPlayers,
+		MaxPlayers:        m.MaxPlayers,
+		DefaultDuration:   m.DefaultDuration,
+		EquipmentTemplate: make([]*eventproto.EquipmentItem, len(m.EquipmentTemplate)),
+	}
+
+	for i, item := range m.EquipmentTemplate {
+		eventType.EquipmentTemplate[i] = &eventproto.EquipmentItem{
+			Name:     item.Name,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return eventType
+}
+
+// toVenueProto converts the venue Swagger model to the proto model.
+// Read-only fields like ID and timestamps are ignored.
+func toVenueProto(m *models.Venue) *eventproto.Venue {
+	venue := &eventproto.Venue{
+		Name:         m.Name,
+		Address:      m.Address,
+		LatLong:      toLatLongProto(m.LatLong),
+		Capacity:     m.Capacity,
+		Surface:      m.Surface,
+		Amenities:    m.Amenities,
+		OpeningHours: make([]*eventproto.OpeningHours, len(m.OpeningHours)),
+		TimeZone:     m.TimeZone,
+	}
+
+	for i, hours := range m.OpeningHours {
+		venue.OpeningHours[i] = &eventproto.OpeningHours{
+			Opens:  hours.Opens,
+			Closes: hours.Closes,
+		}
+
+		for day, dayCode := range weekdays {
+			if dayCode == hours.Day {
+				venue.OpeningHours[i].Day = eventproto.Weekday(day)
+			}
+		}
+	}
+
+	return venue
+}
+
+// toTemplateProto converts the event template Swagger model to the proto model.
+// Read-only fields like ID and timestamps are ignored, the event ID is a separate field of the creation request.
+func toTemplateProto(m *models.EventTemplate) *eventproto.EventTemplate {
+	template := &eventproto.EventTemplate{
+		Name:    m.Name,
+		OwnerId: m.OwnerID,
+	}
+
+	if m.Event != nil {
+		template.Event = toEventProto(m.Event)
+	}
+
+	return template
+}
+
+// toLeagueProto converts the league Swagger model to the proto model.
+// Read-only fields like IDs and timestamps are ignored.
+func toLeagueProto(m *models.League) *eventproto.League {
+	league := &eventproto.League{
+		Name:        m.Name,
+		EventType:   m.EventType,
+		OrganizerId: m.OrganizerID,
+		Teams:       make([]*eventproto.LeagueTeam, len(m.Teams)),
+	}
+
+	for i, team := range m.Teams {
+		league.Teams[i] = &eventproto.LeagueTeam{
+			Name: team.Name,
+		}
+	}
+
+	return league
+}
+
+// toSeasonProto converts the season Swagger model of the league with the given ID to the proto model.
+// Read-only fields like ID, fixtures and timestamps are ignored.
+func toSeasonProto(leagueID string, m *models.Season) *eventproto.Season {
+	return &eventproto.Season{
+		LeagueId: leagueID,
+		Name:     m.Name,
+		TeamIds:  m.TeamIds,
+	}
+}
+
+// toPlayerGroupsProto converts the groups of user IDs of a team generation to the proto model.
+func toPlayerGroupsProto(groups [][]string) []*eventproto.PlayerGroup {
+	result := make([]*eventproto.PlayerGroup, len(groups))
+	for i, userIDs := range groups {
+		result[i] = &eventproto.PlayerGroup{
+			UserIds: userIDs,
+		}
+	}
+
+	return result
+}
+
+// toTeamScoreProtos converts the team score Swagger models to the proto models.
+func toTeamScoreProtos(scores []*models.TeamScore) []*eventproto.TeamScore {
+	result := make([]*eventproto.TeamScore, len(scores))
+	for i, score := range scores {
+		result[i] = &eventproto.TeamScore{
+			Team:  score.Team,
+			Score: score.Score,
+		}
+	}
+
+	return result
+}
+
+// toPlayerStatsProtos converts the player statistics Swagger models to the proto models.
+func toPlayerStatsProtos(playerStats []*models.PlayerStats) []*eventproto.PlayerStats {
+	result := make([]*eventproto.PlayerStats, len(playerStats))
+	for i, stats := range playerStats {
+		result[i] = &eventproto.PlayerStats{
+			User:  toUserProto(stats.User),
+			Stats: stats.Stats,
+		}
+	}
+
+	return result
+}
+
+// toUserProto converts the user reference Swagger model to the proto model.
+func toUserProto(m *models.UserRef) *eventproto.User {
+	if m == nil {
+		return nil
+	}
+
+	return &eventproto.User{
+		Id:   m.ID,
+		Name: m.Name,
+	}
+}
+
+// toLatLongProto converts the location Swagger model to the proto model.
+func toLatLongProto(m *models.LatLong) *eventproto.LatLong {
+	if m == nil {
+		return nil
+	}
+
+	return &eventproto.LatLong{
+		Latitude:  m.Latitude,
+		Longitude: m.Longitude,
+	}
+}
+
+// toRecurrenceProto converts the recurrence Swagger model to the proto model.
+func toRecurrenceProto(m *models.Recurrence) *eventproto.Recurrence {
+	if m == nil {
+		return nil
+	}
+
+	recurrence := &eventproto.Recurrence{
+		Interval: m.Interval,
+		ByDay:    make([]eventproto.Weekday, len(m.ByDay)),
+		Count:    m.Count,
+		Until:    toTimestamp(m.Until),
+	}
+
+	for frequency, name := range frequencies {
+		if name == m.Frequency {
+			recurrence.Frequency = frequency
+		}
+	}
+
+	for i, code := range m.ByDay {
+		for day, dayCode := range weekdays {
+			if dayCode == code {
+				recurrence.ByDay[i] = eventproto.Weekday(day)
+			}
+		}
+	}
+
+	return recurrence
+}
+
+// toOccurrenceExceptionProto converts the occurrence exception Swagger model to the proto model.
+func toOccurrenceExceptionProto(m *models.OccurrenceException) *eventproto.OccurrenceException {
+	return &eventproto.OccurrenceException{
+		OriginalStartTime: toTimestamp(m.OriginalStartTime),
+		Cancelled:         m.Cancelled,
+		StartTime:         toTimestamp(m.StartTime),
+		Duration:          toInt64Proto(m.Duration),
+		LatLong:           toLatLongProto(m.LatLong),
+		Description:       m.Description,
+	}
+}
+
+// parseLatLong parses the location given as "latitude,longitude" to the proto model.
+func parseLatLong(s string) (*eventproto.LatLong, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return nil, errors.Errorf("location '%s' must be formatted as latitude,longitude", s)
+	}
+
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid latitude of location '%s'", s)
+	}
+
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid longitude of location '%s'", s)
+	}
+
+	return &eventproto.LatLong{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}, nil
+}
+
+// toTimestamp converts the Swagger date-time to the timestamp proto model.
+// The zero date-time is converted to an unset timestamp.
+func toTimestamp(dt strfmt.DateTime) *timestamp.Timestamp {
+	t := time.Time(dt)
+	if t.IsZero() {
+		return nil
+	}
+
+	ts, _ := ptypes.TimestampProto(t)
+	return ts
+}
+
+// toInt64Proto converts the given value to the proto wrapper. Zero is converted to an unset wrapper.
+func toInt64Proto(v int64) *common.Int64 {
+	if v == 0 {
+		return nil
+	}
+
+	return &common.Int64{
+		Value: v,
 	}
 }
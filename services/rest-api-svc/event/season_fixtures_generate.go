@@ -0,0 +1,48 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// seasonFixturesGenerate is the handler of the fixtures generation endpoint.
+// This func calls the fixtures generation endpoint of event-svc with the given data.
+func (h *RestHandler) seasonFixturesGenerate(params operations.SeasonFixturesGenerateParams) middleware.Responder {
+	// Call endpoint to generate the fixtures of the season against the given venues and dates.
+	resp, err := h.eventService.GenerateFixtures(params.HTTPRequest.Context(), &eventproto.GenerateFixturesRequest{
+		SeasonId:          params.SeasonID.String(),
+		OrganizerId:       params.Schedule.OrganizerID,
+		VenueIds:          params.Schedule.VenueIds,
+		FirstRoundStart:   toTimestamp(params.Schedule.FirstRoundStart),
+		RoundIntervalDays: params.Schedule.RoundIntervalDays,
+		DoubleRoundRobin:  params.Schedule.DoubleRoundRobin,
+		TimeZone:          params.Schedule.TimeZone,
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toSeasonModel(resp.GetSeason())
+
+	// Return the season model.
+	return operations.NewSeasonFixturesGenerateOK().WithPayload(model)
+}
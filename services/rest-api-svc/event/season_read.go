@@ -0,0 +1,42 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// seasonRead is the handler of the season reading endpoint.
+// This func calls the season reading endpoint of event-svc with the given data.
+func (h *RestHandler) seasonRead(params operations.SeasonReadParams) middleware.Responder {
+	// Call endpoint to read the season by its ID.
+	resp, err := h.eventService.ReadSeason(params.HTTPRequest.Context(), &eventproto.ReadSeasonRequest{
+		SeasonId: params.SeasonID.String(),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toSeasonModel(resp.GetSeason())
+
+	// Return the season model.
+	return operations.NewSeasonReadOK().WithPayload(model)
+}
@@ -0,0 +1,46 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// seasonStandingsRead is the handler of the standings reading endpoint.
+// This func calls the standings reading endpoint of event-svc with the given data.
+func (h *RestHandler) seasonStandingsRead(params operations.SeasonStandingsReadParams) middleware.Responder {
+	// Call endpoint to read the standings of the season.
+	resp, err := h.eventService.ReadStandings(params.HTTPRequest.Context(), &eventproto.ReadStandingsRequest{
+		SeasonId: params.SeasonID.String(),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the Swagger models.
+	standings := make([]*models.Standing, len(resp.GetData().GetStandings()))
+	for i, standing := range resp.GetData().GetStandings() {
+		standings[i] = toStandingModel(standing)
+	}
+
+	// Return standing models.
+	return operations.NewSeasonStandingsReadOK().WithPayload(standings)
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// userAttendanceRead is the handler of the user attendance reading endpoint.
+// This func calls the user attendance reading endpoint of event-svc with the given data.
+func (h *RestHandler) userAttendanceRead(params operations.UserAttendanceReadParams) middleware.Responder {
+	// Call endpoint to count the events the given user showed up at.
+	resp, err := h.eventService.ReadUserAttendance(params.HTTPRequest.Context(), &eventproto.ReadUserAttendanceRequest{
+		UserId: params.UserID.String(),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto model to the Swagger model.
+	model := toUserAttendanceModel(resp.GetAttendance())
+
+	// Return the user attendance model.
+	return operations.NewUserAttendanceReadOK().WithPayload(model)
+}
@@ -0,0 +1,63 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+	"github.com/marboga/gametimehero/utils/signature"
+)
+
+// userCalendarExport is the handler of the calendar feed endpoint.
+// This func calls the user events listing endpoint of event-svc and renders the events as an iCalendar.
+func (h *RestHandler) userCalendarExport(params operations.UserCalendarExportParams) middleware.Responder {
+	// Read the feed of the user to check its token, the tokens signed with a former salt are revoked.
+	userID := params.UserID.String()
+	feedResp, err := h.eventService.ReadCalendarFeed(params.HTTPRequest.Context(), &eventproto.ReadCalendarFeedRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	}
+
+	if !signature.Verify(h.calendarSecret, params.Token, calendarTokenScope, userID, feedResp.GetFeed().GetSalt()) {
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, "invalid calendar token", http.StatusForbidden)
+		})
+	}
+
+	// Call endpoint to list the events created or joined by the user.
+	resp, err := h.eventService.ListUserEvents(params.HTTPRequest.Context(), &eventproto.ListUserEventsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the calendar.
+	calendar := toCalendar(calendarName, resp.GetData().GetEvents()...)
+
+	// Return the calendar.
+	return operations.NewUserCalendarExportOK().WithPayload(calendar.String())
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+	"github.com/marboga/gametimehero/utils/signature"
+)
+
+// userCalendarFeedRead is the handler of the calendar feed reading endpoint.
+// This func calls the calendar feed reading endpoint of event-svc and signs the token of the feed.
+func (h *RestHandler) userCalendarFeedRead(params operations.UserCalendarFeedReadParams) middleware.Responder {
+	// Call endpoint to read the calendar feed of the user.
+	resp, err := h.eventService.ReadCalendarFeed(params.HTTPRequest.Context(), &eventproto.ReadCalendarFeedRequest{
+		UserId: params.UserID.String(),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Return the calendar feed model.
+	return operations.NewUserCalendarFeedReadOK().WithPayload(h.toCalendarFeedModel(resp.GetFeed()))
+}
+
+// toCalendarFeedModel converts the calendar feed proto model to the Swagger model with a signed URL.
+// The salt of the feed is signed with the user ID, so rotating it revokes the URLs given out before.
+func (h *RestHandler) toCalendarFeedModel(feed *eventproto.CalendarFeed) *models.CalendarFeed {
+	token := signature.Sign(h.calendarSecret, calendarTokenScope, feed.GetUserId(), feed.GetSalt())
+
+	return &models.CalendarFeed{
+		URL:   fmt.Sprintf("/user/%s/calendar.ics?token=%s", feed.GetUserId(), token),
+		Token: token,
+	}
+}
@@ -0,0 +1,39 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// userCalendarFeedRotate is the handler of the calendar feed rotation endpoint.
+// This func calls the calendar feed rotation endpoint of event-svc and signs the new token of the feed.
+func (h *RestHandler) userCalendarFeedRotate(params operations.UserCalendarFeedRotateParams) middleware.Responder {
+	// Call endpoint to give a new salt to the calendar feed of the user.
+	resp, err := h.eventService.RotateCalendarFeed(params.HTTPRequest.Context(), &eventproto.RotateCalendarFeedRequest{
+		UserId: params.UserID.String(),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Return the calendar feed model.
+	return operations.NewUserCalendarFeedRotateOK().WithPayload(h.toCalendarFeedModel(resp.GetFeed()))
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/models"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// userInvitationsList is the handler of the user invitations listing endpoint.
+// This func calls the invitations listing endpoint of event-svc with the given data.
+func (h *RestHandler) userInvitationsList(params operations.UserInvitationsListParams) middleware.Responder {
+	// Call endpoint to list the pending invitations of the given user.
+	resp, err := h.eventService.ListUserInvitations(params.HTTPRequest.Context(), &eventproto.ListUserInvitationsRequest{
+		UserId: params.UserID.String(),
+	})
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the Swagger models.
+	invitations := toInvitationModels(resp.GetData().GetInvitations())
+
+	// Return invitation models.
+	return operations.NewUserInvitationsListOK().WithPayload(models.InvitationsList(invitations))
+}
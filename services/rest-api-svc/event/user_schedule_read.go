@@ -0,0 +1,51 @@
+package event
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
+	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
+)
+
+// userScheduleRead is the handler of the user schedule reading endpoint.
+// This func calls the user schedule reading endpoint of event-svc with the given data.
+func (h *RestHandler) userScheduleRead(params operations.UserScheduleReadParams) middleware.Responder {
+	// Prepare the optional bounds of the timeline.
+	req := &eventproto.ReadUserScheduleRequest{
+		UserId: params.UserID.String(),
+	}
+	if params.StartAfter != nil {
+		req.StartAfter = toTimestamp(*params.StartAfter)
+	}
+	if params.StartBefore != nil {
+		req.StartBefore = toTimestamp(*params.StartBefore)
+	}
+
+	// Call endpoint to merge the events of the given user into one timeline.
+	resp, err := h.eventService.ReadUserSchedule(params.HTTPRequest.Context(), req)
+	if err != nil {
+		// Handle the given error and return 500 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		})
+	} else if resp.GetError().GetCode() != 0 {
+		// Handle the given logic error and return 400 status code.
+		// Also, write error message into the response.
+		// Error handling can be with more clear way, but it's just an example.
+		// Here gonna be mapping between RPC and HTTP status codes.
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.Error(w, resp.GetError().GetMessage(), http.StatusBadRequest)
+		})
+	}
+
+	// Convert proto models to the Swagger models.
+	schedule := toScheduleEntryModels(resp.GetData().GetEntries())
+
+	// Return the schedule model.
+	return operations.NewUserScheduleReadOK().WithPayload(schedule)
+}
@@ -1,7 +1,11 @@
 package restapisvc
 
 import (
+	"net/http"
+	"regexp"
+
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/sirupsen/logrus"
 
 	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi"
@@ -15,5 +19,28 @@ func NewRestAPI(log *logrus.Logger) *operations.RestAPISvcAPI {
 		log.Fatalln(err)
 	}
 
-	return operations.NewRestAPISvcAPI(swaggerSpec)
+	api := operations.NewRestAPISvcAPI(swaggerSpec)
+
+	// Calendars are rendered by the handlers, so they are written as is.
+	api.RegisterProducer("text/calendar", runtime.TextProducer())
+
+	return api
+}
+
+// eventExportPath matches the path of the event export, e.g. /event/{event_id}.ics.
+// The swagger router drops the extension after a path parameter, so it can't tell this path from the event reading one.
+var eventExportPath = regexp.MustCompile(`^/event/([^/]+)\.ics$`)
+
+// ServeEventExport wraps the handler of the API to serve GET /event/{event_id}.ics.
+// The request is passed to the event export endpoint, which the swagger spec declares under /event/{event_id}/calendar.ics.
+func ServeEventExport(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m := eventExportPath.FindStringSubmatch(r.URL.Path); m != nil && r.Method == http.MethodGet {
+			r = r.Clone(r.Context())
+			r.URL.Path = "/event/" + m[1] + "/calendar.ics"
+			r.URL.RawPath = ""
+		}
+
+		next.ServeHTTP(w, r)
+	})
 }
@@ -14,4 +14,10 @@ var flags = []cli.Flag{
 		Usage:       "Set to true if we are running in docker-compose",
 		Destination: &opts.IsTest,
 	},
+	&cli.StringFlag{
+		Name:        "calendar_secret",
+		EnvVars:     []string{"CALENDAR_SECRET"},
+		Usage:       "The secret used to sign the tokens of the calendar feeds",
+		Destination: &opts.CalendarSecret,
+	},
 }
@@ -8,8 +8,10 @@ import (
 	"github.com/sirupsen/logrus"
 
 	accountproto "github.com/marboga/gametimehero/proto/account-svc"
+	eventproto "github.com/marboga/gametimehero/proto/event-svc"
 	restapisvc "github.com/marboga/gametimehero/services/rest-api-svc"
 	"github.com/marboga/gametimehero/services/rest-api-svc/account"
+	"github.com/marboga/gametimehero/services/rest-api-svc/event"
 	"github.com/marboga/gametimehero/services/rest-api-svc/swaggergen/restapi/operations"
 	"github.com/marboga/gametimehero/utils/rpc"
 )
@@ -24,7 +26,7 @@ type MicroService struct {
 func Init(clientOpts *ClientOptions) (*MicroService, error) {
 	// Create micro-service.
 	svc := web.NewService(
-		web.Name(rpc.AccountServiceName),
+		web.Name(rpc.RestAPIServiceName),
 		web.Version(clientOpts.Version),
 		web.Flags(flags...),
 		web.BeforeStart(func() error {
@@ -42,14 +44,22 @@ func Init(clientOpts *ClientOptions) (*MicroService, error) {
 
 // New is the constructor of the service.
 func New(svc web.Service, clientOpts *ClientOptions) (*MicroService, error) {
-	// Init dependencies. Here we create a client to send RPC requests to account-svc.
+	// Init dependencies. Here we create clients to send RPC requests to account-svc and event-svc.
 	accountClient := accountproto.NewAccountService(rpc.AccountServiceName, client.DefaultClient)
+	eventClient := eventproto.NewEventService(rpc.EventServiceName, client.DefaultClient)
+	commentClient := eventproto.NewCommentService(rpc.EventServiceName, client.DefaultClient)
 
-	// Create handler of REST endpoints.
+	// Create handlers of REST endpoints.
 	accountHandler := account.NewRestHandler(&account.RestHandlerOptions{
 		AccountService: accountClient,
 		Logger:         clientOpts.Log,
 	})
+	eventHandler := event.NewRestHandler(&event.RestHandlerOptions{
+		EventService:   eventClient,
+		CommentService: commentClient,
+		Logger:         clientOpts.Log,
+		CalendarSecret: opts.CalendarSecret,
+	})
 
 	// Create API.
 	restAPI := restapisvc.NewRestAPI(clientOpts.Log)
@@ -61,9 +71,10 @@ func New(svc web.Service, clientOpts *ClientOptions) (*MicroService, error) {
 
 	// Register API.
 	accountHandler.Register(restAPI)
+	eventHandler.Register(restAPI)
 
 	// Setup handler.
-	svc.Handle("/", restAPI.Serve(nil))
+	svc.Handle("/", restapisvc.ServeEventExport(restAPI.Serve(nil)))
 
 	// Initialize service with updated configuration.
 	if err := svc.Init(); err != nil {
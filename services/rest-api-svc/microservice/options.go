@@ -1,14 +1,22 @@
 package microservice
 
-import "github.com/sirupsen/logrus"
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
 
 // Options contains the configuration parameters of the service.
 type Options struct {
-	IsTest bool
+	IsTest         bool
+	CalendarSecret string
 }
 
 // Validate applies the validation logic to the options.
 func (opts *Options) Validate() error {
+	if opts.CalendarSecret == "" {
+		return errors.New("calendar secret is required")
+	}
+
 	return nil
 }
 
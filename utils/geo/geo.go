@@ -0,0 +1,163 @@
+// Package geo contains geographic helpers: great-circle distances and geohash cells
+// which are used to index locations in a store.
+package geo
+
+import "math"
+
+const (
+	// earthRadiusKm is the mean radius of the Earth.
+	earthRadiusKm = 6371.0
+
+	// kmPerDegree is the length of a degree of latitude.
+	kmPerDegree = math.Pi * earthRadiusKm / 180
+
+	// maxCells limits the number of cells returned by Cover.
+	maxCells = 64
+)
+
+// base32 is the geohash alphabet.
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Distance returns the great-circle distance in kilometers between two points given in degrees.
+func Distance(lat1, long1, lat2, long2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dPhi, dLambda := radians(lat2-lat1), radians(long2-long1)
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Valid returns true if the given coordinates are within the valid ranges.
+func Valid(lat, long float64) bool {
+	return lat >= -90 && lat <= 90 && long >= -180 && long <= 180
+}
+
+// Encode returns the geohash of the given point with the given number of characters.
+func Encode(lat, long float64, precision int) string {
+	latIndex, longIndex := cellIndex(lat, long, precision)
+	return encodeIndex(latIndex, longIndex, precision)
+}
+
+// Cover returns the geohash cells which cover the circle with the given center and radius.
+// The precision of the cells is chosen to keep their number small, but never exceeds the given one.
+func Cover(lat, long, radiusKm float64, maxPrecision int) []string {
+	precision := maxPrecision
+	for ; precision > 1; precision-- {
+		if countCells(lat, long, radiusKm, precision) <= maxCells {
+			break
+		}
+	}
+
+	minLatIndex, maxLatIndex, minLongIndex, maxLongIndex := cellRange(lat, long, radiusKm, precision)
+	longCells := 1 << uint(longBits(precision))
+
+	var cells []string
+	seen := make(map[string]bool)
+	for latIndex := minLatIndex; latIndex <= maxLatIndex; latIndex++ {
+		for longIndex := minLongIndex; longIndex <= maxLongIndex; longIndex++ {
+			// Wrap around the antimeridian.
+			cell := encodeIndex(latIndex, ((longIndex%longCells)+longCells)%longCells, precision)
+			if !seen[cell] {
+				seen[cell] = true
+				cells = append(cells, cell)
+			}
+		}
+	}
+
+	return cells
+}
+
+// countCells returns the number of cells of the given precision which cover the circle.
+func countCells(lat, long, radiusKm float64, precision int) int {
+	minLatIndex, maxLatIndex, minLongIndex, maxLongIndex := cellRange(lat, long, radiusKm, precision)
+	longCells := 1 << uint(longBits(precision))
+
+	longCount := maxLongIndex - minLongIndex + 1
+	if longCount > longCells {
+		longCount = longCells
+	}
+
+	return (maxLatIndex - minLatIndex + 1) * longCount
+}
+
+// cellRange returns the ranges of cell indexes covering the bounding box of the circle.
+// The longitude indexes may exceed the grid when the box crosses the antimeridian.
+func cellRange(lat, long, radiusKm float64, precision int) (int, int, int, int) {
+	dLat := radiusKm / kmPerDegree
+	minLat, maxLat := math.Max(-90, lat-dLat), math.Min(90, lat+dLat)
+
+	longCell := 360 / float64(int(1)<<uint(longBits(precision)))
+
+	minLatIndex, _ := cellIndex(minLat, long, precision)
+	maxLatIndex, _ := cellIndex(maxLat, long, precision)
+
+	// Near the poles every longitude is within the radius.
+	cosLat := math.Cos(radians(math.Max(math.Abs(minLat), math.Abs(maxLat))))
+	if cosLat < 1e-9 || radiusKm/(kmPerDegree*cosLat) >= 180 {
+		return minLatIndex, maxLatIndex, 0, (1 << uint(longBits(precision))) - 1
+	}
+
+	dLong := radiusKm / (kmPerDegree * cosLat)
+	minLongIndex := int(math.Floor((long - dLong + 180) / longCell))
+	maxLongIndex := int(math.Floor((long + dLong + 180) / longCell))
+
+	return minLatIndex, maxLatIndex, minLongIndex, maxLongIndex
+}
+
+// cellIndex returns the row and column of the cell containing the given point.
+func cellIndex(lat, long float64, precision int) (int, int) {
+	latCells := 1 << uint(latBits(precision))
+	longCells := 1 << uint(longBits(precision))
+
+	latIndex := int((lat + 90) / 180 * float64(latCells))
+	longIndex := int((long + 180) / 360 * float64(longCells))
+
+	// The upper bounds belong to the last cell.
+	if latIndex >= latCells {
+		latIndex = latCells - 1
+	}
+	if longIndex >= longCells {
+		longIndex = longCells - 1
+	}
+
+	return latIndex, longIndex
+}
+
+// encodeIndex interleaves the bits of the given cell indexes into a geohash.
+func encodeIndex(latIndex, longIndex int, precision int) string {
+	latBit, longBit := latBits(precision)-1, longBits(precision)-1
+
+	hash := make([]byte, precision)
+	for i := 0; i < precision; i++ {
+		var char int
+		for bit := 0; bit < 5; bit++ {
+			char <<= 1
+			// Even bits encode the longitude, odd bits the latitude.
+			if (i*5+bit)%2 == 0 {
+				char |= (longIndex >> uint(longBit)) & 1
+				longBit--
+			} else {
+				char |= (latIndex >> uint(latBit)) & 1
+				latBit--
+			}
+		}
+		hash[i] = base32[char]
+	}
+
+	return string(hash)
+}
+
+// latBits returns the number of latitude bits of a geohash with the given precision.
+func latBits(precision int) int {
+	return precision * 5 / 2
+}
+
+// longBits returns the number of longitude bits of a geohash with the given precision.
+func longBits(precision int) int {
+	return precision*5 - latBits(precision)
+}
+
+// radians converts degrees to radians.
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,51 @@
+package geo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/geo"
+)
+
+func TestDistance(t *testing.T) {
+	// Paris to London.
+	require.InDelta(t, 343.5, geo.Distance(48.8566, 2.3522, 51.5074, -0.1278), 1)
+	require.Zero(t, geo.Distance(10, 10, 10, 10))
+}
+
+func TestEncode(t *testing.T) {
+	require.Equal(t, "u09tvw", geo.Encode(48.8566, 2.3522, 6))
+	require.Equal(t, "ezs42", geo.Encode(42.605, -5.603, 5))
+}
+
+func TestCover(t *testing.T) {
+	t.Run("covers nearby points", func(t *testing.T) {
+		cells := geo.Cover(48.8566, 2.3522, 5, 6)
+
+		for _, point := range [][2]float64{{48.8566, 2.3522}, {48.89, 2.35}, {48.8566, 2.41}} {
+			require.True(t, covered(cells, geo.Encode(point[0], point[1], 6)), "point %v", point)
+		}
+	})
+
+	t.Run("wraps around the antimeridian", func(t *testing.T) {
+		cells := geo.Cover(0, 179.99, 10, 6)
+
+		require.True(t, covered(cells, geo.Encode(0, -179.99, 6)))
+	})
+
+	t.Run("limits the number of cells", func(t *testing.T) {
+		require.LessOrEqual(t, len(geo.Cover(48.8566, 2.3522, 500, 6)), 64)
+	})
+}
+
+func covered(cells []string, hash string) bool {
+	for _, cell := range cells {
+		if strings.HasPrefix(hash, cell) {
+			return true
+		}
+	}
+
+	return false
+}
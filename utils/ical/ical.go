@@ -0,0 +1,224 @@
+// Package ical writes iCalendar (RFC 5545) calendars, so events can be subscribed to from calendar applications.
+// Only the VEVENT properties needed by the events are supported.
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Status is the status of an event.
+type Status string
+
+// Supported statuses.
+const (
+	StatusTentative Status = "TENTATIVE"
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+const (
+	// dateTimeFormat is the format of UTC date-times.
+	dateTimeFormat = "20060102T150405Z"
+
+	// localDateTimeFormat is the format of the date-times in the time zone given by their TZID parameter.
+	localDateTimeFormat = "20060102T150405"
+
+	// maxLineLength is the maximum length of a line in octets, longer lines are folded.
+	maxLineLength = 75
+)
+
+// Calendar is a calendar object containing events.
+type Calendar struct {
+	// ProductID identifies the product which created the calendar.
+	ProductID string
+
+	// Name is the name displayed by calendar applications.
+	Name string
+
+	Events []Event
+}
+
+// Event is an event component of a calendar.
+// The times of an event in a time zone other than UTC are written as local date-times of that zone
+// with a TZID parameter, so calendar applications keep the wall clock time of recurring events across DST changes.
+// The calendar describes each of these zones in a VTIMEZONE component.
+type Event struct {
+	// UID identifies the event. All occurrences of a recurring event share the same UID.
+	UID string
+
+	// Sequence is the revision of the event. Calendar applications replace the events with a lower sequence.
+	Sequence int64
+
+	// Stamp is the time the event was last modified.
+	Stamp time.Time
+
+	Start time.Time
+
+	// End is omitted if it's zero.
+	End time.Time
+
+	// RecurrenceID is the original start time of the occurrence overridden by this event.
+	// It's omitted if it's zero.
+	RecurrenceID time.Time
+
+	Summary     string
+	Description string
+	Location    string
+
+	// Geo is the geographic position of the event, it's omitted if nil.
+	Geo *Geo
+
+	// Status is omitted if empty.
+	Status Status
+
+	// RRule is the recurrence rule of the event in the RRULE format, it's omitted if empty.
+	RRule string
+
+	// ExDates are the start times of the cancelled occurrences of a recurring event.
+	ExDates []time.Time
+
+	// Err is the first invalid property of a parsed event, if any. It isn't written.
+	Err error
+}
+
+// Geo is a geographic position in degrees.
+type Geo struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// String returns the calendar in the iCalendar format.
+func (c *Calendar) String() string {
+	w := &writer{}
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", c.ProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escape(c.Name))
+	}
+
+	// Every TZID of the events refers to a time zone component of the calendar.
+	for _, t := range c.usedTimeZones() {
+		w.timeZone(t)
+	}
+
+	for _, event := range c.Events {
+		w.event(event)
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.String()
+}
+
+// writer builds the content lines of a calendar.
+type writer struct {
+	strings.Builder
+}
+
+// event writes the given event component.
+func (w *writer) event(e Event) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", e.UID)
+	w.line("SEQUENCE", strconv.FormatInt(e.Sequence, 10))
+	w.line("DTSTAMP", formatTime(e.Stamp))
+	w.time("DTSTART", e.Start)
+
+	if !e.End.IsZero() {
+		w.time("DTEND", e.End)
+	}
+
+	if !e.RecurrenceID.IsZero() {
+		w.time("RECURRENCE-ID", e.RecurrenceID)
+	}
+
+	w.line("SUMMARY", escape(e.Summary))
+
+	if e.Description != "" {
+		w.line("DESCRIPTION", escape(e.Description))
+	}
+
+	if e.Location != "" {
+		w.line("LOCATION", escape(e.Location))
+	}
+
+	if e.Geo != nil {
+		w.line("GEO", formatFloat(e.Geo.Latitude)+";"+formatFloat(e.Geo.Longitude))
+	}
+
+	if e.Status != "" {
+		w.line("STATUS", string(e.Status))
+	}
+
+	if e.RRule != "" {
+		w.line("RRULE", e.RRule)
+	}
+
+	for _, exDate := range e.ExDates {
+		w.time("EXDATE", exDate)
+	}
+
+	w.line("END", "VEVENT")
+}
+
+// time writes a date-time content line, with the TZID of the given time unless it's in UTC.
+func (w *writer) time(name string, t time.Time) {
+	if hasTimeZone(t) {
+		w.line(name+";TZID="+t.Location().String(), t.Format(localDateTimeFormat))
+		return
+	}
+
+	w.line(name, formatTime(t))
+}
+
+// hasTimeZone returns true if the given time is written as a local date-time with a TZID.
+func hasTimeZone(t time.Time) bool {
+	zone := t.Location().String()
+	return zone != "UTC" && zone != "Local"
+}
+
+// line writes a content line, folding it if it's too long.
+func (w *writer) line(name, value string) {
+	line := name + ":" + value
+
+	// Folded lines start with a space, which counts in their length.
+	for limit := maxLineLength; len(line) > limit; limit = maxLineLength - 1 {
+		// Never split a multi-byte character.
+		i := limit
+		for !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		w.WriteString(line[:i] + "\r\n ")
+		line = line[i:]
+	}
+
+	w.WriteString(line + "\r\n")
+}
+
+// escape escapes the special characters of a text value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// formatTime formats the given time as a UTC date-time.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// formatFloat formats the given coordinate.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,239 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/ical"
+)
+
+var start = time.Date(2021, time.February, 1, 19, 0, 0, 0, time.UTC)
+
+func TestString(t *testing.T) {
+	calendar := &ical.Calendar{
+		ProductID: "-//Test//EN",
+		Events: []ical.Event{{
+			UID:         "1@test",
+			Sequence:    2,
+			Stamp:       start,
+			Start:       start,
+			End:         start.Add(time.Hour),
+			Summary:     "Pickup game; bring water, shoes",
+			Description: "Line one\nLine two",
+			Geo:         &ical.Geo{Latitude: 48.8566, Longitude: 2.3522},
+			Status:      ical.StatusConfirmed,
+			RRule:       "FREQ=WEEKLY",
+			ExDates:     []time.Time{start.AddDate(0, 0, 7)},
+		}},
+	}
+
+	require.Equal(t, strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Test//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"BEGIN:VEVENT",
+		"UID:1@test",
+		"SEQUENCE:2",
+		"DTSTAMP:20210201T190000Z",
+		"DTSTART:20210201T190000Z",
+		"DTEND:20210201T200000Z",
+		`SUMMARY:Pickup game\; bring water\, shoes`,
+		`DESCRIPTION:Line one\nLine two`,
+		"GEO:48.8566;2.3522",
+		"STATUS:CONFIRMED",
+		"RRULE:FREQ=WEEKLY",
+		"EXDATE:20210208T190000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), calendar.String())
+}
+
+func TestStringTimeZone(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	local := time.Date(2021, time.March, 22, 19, 0, 0, 0, paris)
+	calendar := &ical.Calendar{
+		Events: []ical.Event{{
+			UID:     "1@test",
+			Stamp:   local,
+			Start:   local,
+			End:     local.Add(time.Hour),
+			RRule:   "FREQ=WEEKLY",
+			ExDates: []time.Time{local.AddDate(0, 0, 7)},
+		}},
+	}
+
+	content := calendar.String()
+	require.Contains(t, content, strings.Join([]string{
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Paris",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20210328T020000",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0200",
+		"TZNAME:CEST",
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"DTSTART:20211031T030000",
+		"TZOFFSETFROM:+0200",
+		"TZOFFSETTO:+0100",
+		"TZNAME:CET",
+		"RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+	}, "\r\n"))
+	require.Contains(t, content, "DTSTAMP:20210322T180000Z\r\n")
+	require.Contains(t, content, "DTSTART;TZID=Europe/Paris:20210322T190000\r\n")
+	require.Contains(t, content, "DTEND;TZID=Europe/Paris:20210322T200000\r\n")
+	require.Contains(t, content, "EXDATE;TZID=Europe/Paris:20210329T190000\r\n")
+
+	parsed, err := ical.Parse(strings.NewReader(content))
+	require.NoError(t, err)
+	require.True(t, local.Equal(parsed.Events[0].Start))
+	require.Equal(t, "Europe/Paris", parsed.Events[0].Start.Location().String())
+	require.Len(t, parsed.Events, 1)
+}
+
+func TestStringTimeZones(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	calendar := &ical.Calendar{
+		Events: []ical.Event{{
+			UID:   "1@test",
+			Start: time.Date(2021, time.June, 1, 19, 0, 0, 0, tokyo),
+		}, {
+			UID:   "2@test",
+			Start: time.Date(2021, time.June, 1, 19, 0, 0, 0, newYork),
+		}, {
+			UID:   "3@test",
+			Start: start,
+		}},
+	}
+
+	content := calendar.String()
+
+	// The zones are written once each, in the order of their names, and UTC needs none.
+	require.Equal(t, 2, strings.Count(content, "BEGIN:VTIMEZONE\r\n"))
+	require.Less(t, strings.Index(content, "TZID:America/New_York"), strings.Index(content, "TZID:Asia/Tokyo"))
+	require.Contains(t, content, "DTSTART:20210314T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\n"+
+		"TZNAME:EDT\r\nRRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU\r\n")
+	require.Contains(t, content, "DTSTART:20211107T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\n"+
+		"TZNAME:EST\r\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU\r\n")
+	require.Contains(t, content, "TZID:Asia/Tokyo\r\nBEGIN:STANDARD\r\nDTSTART:19700101T000000\r\n"+
+		"TZOFFSETFROM:+0900\r\nTZOFFSETTO:+0900\r\nTZNAME:JST\r\nEND:STANDARD\r\nEND:VTIMEZONE\r\n")
+
+	parsed, err := ical.Parse(strings.NewReader(content))
+	require.NoError(t, err)
+	require.Len(t, parsed.Events, 3)
+	require.True(t, calendar.Events[1].Start.Equal(parsed.Events[1].Start))
+}
+
+func TestFolding(t *testing.T) {
+	for _, summary := range []string{strings.Repeat("a", 200), strings.Repeat("é", 100)} {
+		calendar := &ical.Calendar{
+			Events: []ical.Event{{
+				Summary: summary,
+			}},
+		}
+
+		content := calendar.String()
+		for _, line := range strings.Split(content, "\r\n") {
+			require.LessOrEqual(t, len(line), 75)
+		}
+
+		require.Contains(t, strings.ReplaceAll(content, "\r\n ", ""), "SUMMARY:"+summary+"\r\n")
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		calendar := &ical.Calendar{
+			ProductID: "-//Test//EN",
+			Name:      "Games",
+			Events: []ical.Event{{
+				UID:         "1@test",
+				Sequence:    2,
+				Stamp:       start,
+				Start:       start,
+				End:         start.Add(time.Hour),
+				Summary:     strings.Repeat("Pickup game; bring water, shoes\\", 5),
+				Description: "Line one\nLine two",
+				Location:    "Central park",
+				Geo:         &ical.Geo{Latitude: 48.8566, Longitude: 2.3522},
+				Status:      ical.StatusConfirmed,
+				RRule:       "FREQ=WEEKLY",
+				ExDates:     []time.Time{start.AddDate(0, 0, 7)},
+			}, {
+				UID:          "1@test",
+				Stamp:        start,
+				Start:        start.AddDate(0, 0, 14).Add(time.Hour),
+				RecurrenceID: start.AddDate(0, 0, 14),
+			}},
+		}
+
+		parsed, err := ical.Parse(strings.NewReader(calendar.String()))
+		require.NoError(t, err)
+		require.Equal(t, calendar, parsed)
+	})
+
+	t.Run("time zones, durations and nested components", func(t *testing.T) {
+		parsed, err := ical.Parse(strings.NewReader(strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"UID:2@test",
+			"DTSTART;TZID=America/New_York:20210201T190000",
+			"DURATION:PT1H30M",
+			"EXDATE;VALUE=DATE:20210208,20210215",
+			"BEGIN:VALARM",
+			"DESCRIPTION:Reminder",
+			"END:VALARM",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\n")))
+		require.NoError(t, err)
+		require.Len(t, parsed.Events, 1)
+
+		event := parsed.Events[0]
+		require.Equal(t, time.Date(2021, time.February, 2, 0, 0, 0, 0, time.UTC), event.Start.UTC())
+		require.Equal(t, 90*time.Minute, event.End.Sub(event.Start))
+		require.Len(t, event.ExDates, 2)
+		require.Empty(t, event.Description)
+	})
+
+	t.Run("invalid property", func(t *testing.T) {
+		parsed, err := ical.Parse(strings.NewReader(strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"UID:1@test",
+			"DTSTART:2021-02-01",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:2@test",
+			"DTSTART:20210201T190000Z",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\n")))
+		require.NoError(t, err)
+		require.Len(t, parsed.Events, 2)
+		require.EqualError(t, parsed.Events[0].Err, `line 4: invalid DTSTART: parsing time "2021-02-01" as "20060102T150405": cannot parse "-02-01" as "01"`)
+		require.NoError(t, parsed.Events[1].Err)
+		require.Equal(t, "2@test", parsed.Events[1].UID)
+	})
+
+	t.Run("malformed line", func(t *testing.T) {
+		_, err := ical.Parse(strings.NewReader("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;20210201\n"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,254 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationPattern matches the durations of RFC 5545, e.g. P1DT2H30M or P2W.
+var durationPattern = regexp.MustCompile(`^([+-]?)P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// property is a content line of a calendar.
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads a calendar in the iCalendar format. Unsupported components and properties are ignored.
+// An invalid property of an event is recorded as the error of that event, the other events are still read.
+// Date-times with a TZID are read in that time zone, floating date-times and dates are read as UTC.
+func Parse(r io.Reader) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &Calendar{}
+	var event *Event
+	var nested int
+	for i, line := range lines {
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+
+		switch {
+		case prop.name == "BEGIN" && prop.value == "VEVENT" && event == nil:
+			event = &Event{}
+		case prop.name == "END" && prop.value == "VEVENT" && nested == 0 && event != nil:
+			calendar.Events = append(calendar.Events, *event)
+			event = nil
+		case prop.name == "BEGIN" && event != nil:
+			// Components nested in events, like alarms, are skipped.
+			nested++
+		case prop.name == "END" && event != nil:
+			nested--
+		case nested > 0:
+		case event != nil:
+			if err := event.set(prop); err != nil && event.Err == nil {
+				event.Err = fmt.Errorf("line %d: %v", i+1, err)
+			}
+		case prop.name == "PRODID":
+			calendar.ProductID = prop.value
+		case prop.name == "X-WR-CALNAME":
+			calendar.Name = unescape(prop.value)
+		}
+	}
+
+	if event != nil {
+		return nil, fmt.Errorf("event %s isn't terminated", event.UID)
+	}
+
+	return calendar, nil
+}
+
+// set sets the event field of the given property.
+func (e *Event) set(prop property) error {
+	var err error
+	switch prop.name {
+	case "UID":
+		e.UID = prop.value
+	case "SEQUENCE":
+		e.Sequence, err = strconv.ParseInt(prop.value, 10, 64)
+	case "DTSTAMP":
+		e.Stamp, err = parseTime(prop)
+	case "DTSTART":
+		e.Start, err = parseTime(prop)
+	case "DTEND":
+		e.End, err = parseTime(prop)
+	case "DURATION":
+		// DTSTART always comes first in practice, an earlier DURATION is ignored.
+		var d time.Duration
+		if d, err = parseDuration(prop.value); err == nil && !e.Start.IsZero() {
+			e.End = e.Start.Add(d)
+		}
+	case "RECURRENCE-ID":
+		e.RecurrenceID, err = parseTime(prop)
+	case "SUMMARY":
+		e.Summary = unescape(prop.value)
+	case "DESCRIPTION":
+		e.Description = unescape(prop.value)
+	case "LOCATION":
+		e.Location = unescape(prop.value)
+	case "GEO":
+		e.Geo, err = parseGeo(prop.value)
+	case "STATUS":
+		e.Status = Status(strings.ToUpper(prop.value))
+	case "RRULE":
+		e.RRule = prop.value
+	case "EXDATE":
+		for _, value := range strings.Split(prop.value, ",") {
+			var exDate time.Time
+			if exDate, err = parseTime(property{name: prop.name, params: prop.params, value: value}); err != nil {
+				break
+			}
+			e.ExDates = append(e.ExDates, exDate)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid %s: %v", prop.name, err)
+	}
+
+	return nil
+}
+
+// unfold reads the content lines, joining the folded ones. Empty lines are dropped.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// parseProperty parses a content line as NAME;PARAM=VALUE:VALUE.
+func parseProperty(line string) (property, error) {
+	// The value starts at the first colon which isn't quoted in a parameter.
+	quoted := false
+	colon := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return property{}, fmt.Errorf("missing value in '%s'", line)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  line[colon+1:],
+	}
+
+	for _, param := range parts[1:] {
+		if i := strings.Index(param, "="); i >= 0 {
+			prop.params[strings.ToUpper(param[:i])] = strings.Trim(param[i+1:], `"`)
+		}
+	}
+
+	return prop, nil
+}
+
+// parseTime parses a date-time or a date property value.
+func parseTime(prop property) (time.Time, error) {
+	if prop.params["VALUE"] == "DATE" || len(prop.value) == len("20060102") {
+		return time.Parse("20060102", prop.value)
+	}
+
+	if strings.HasSuffix(prop.value, "Z") {
+		return time.Parse(dateTimeFormat, prop.value)
+	}
+
+	location := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		var err error
+		if location, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone '%s'", tzid)
+		}
+	}
+
+	return time.ParseInLocation(localDateTimeFormat, prop.value, location)
+}
+
+// parseDuration parses a duration property value.
+func parseDuration(s string) (time.Duration, error) {
+	match := durationPattern.FindStringSubmatch(s)
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration '%s'", s)
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if match[i+2] != "" {
+			n, _ := strconv.Atoi(match[i+2])
+			d += time.Duration(n) * unit
+		}
+	}
+
+	if match[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// parseGeo parses a GEO property value given as latitude;longitude.
+func parseGeo(s string) (*Geo, error) {
+	parts := strings.Split(s, ";")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid position '%s'", s)
+	}
+
+	latitude, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	longitude, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Geo{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}, nil
+}
+
+// unescape reverts the escaping of a text value.
+func unescape(s string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(s)
+}
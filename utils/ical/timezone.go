@@ -0,0 +1,177 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// weekdayCodes contains the RRULE codes of the weekdays indexed by time.Weekday.
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// observance is a period of a time zone with the same UTC offset, written as a STANDARD or a DAYLIGHT component.
+type observance struct {
+	daylight bool
+
+	// start is the local date-time of the transition in the offset it comes from.
+	start time.Time
+
+	// from and to are the UTC offsets in seconds before and after the transition.
+	from, to int
+
+	// name is the abbreviation of the zone after the transition, e.g. CEST.
+	name string
+
+	// rule is the yearly recurrence of the transition in the RRULE format, it's empty if the transition doesn't repeat.
+	rule string
+}
+
+// usedTimeZones returns the time zones of the date-times written with a TZID, sorted by name,
+// each one with the earliest of its date-times.
+func (c *Calendar) usedTimeZones() []time.Time {
+	earliest := make(map[string]time.Time)
+	add := func(t time.Time) {
+		if t.IsZero() || !hasTimeZone(t) {
+			return
+		}
+
+		zone := t.Location().String()
+		if first, ok := earliest[zone]; !ok || t.Before(first) {
+			earliest[zone] = t
+		}
+	}
+
+	for _, event := range c.Events {
+		add(event.Start)
+		add(event.End)
+		add(event.RecurrenceID)
+		for _, exDate := range event.ExDates {
+			add(exDate)
+		}
+	}
+
+	zones := make([]time.Time, 0, len(earliest))
+	for _, t := range earliest {
+		zones = append(zones, t)
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Location().String() < zones[j].Location().String()
+	})
+
+	return zones
+}
+
+// timeZone writes the VTIMEZONE component of the time zone of the given time.
+// The transitions of the year of the given time are written as yearly rules, so the component also covers
+// the following years as long as the rules of the zone don't change.
+func (w *writer) timeZone(t time.Time) {
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", t.Location().String())
+
+	for _, o := range observances(t) {
+		component := "STANDARD"
+		if o.daylight {
+			component = "DAYLIGHT"
+		}
+
+		w.line("BEGIN", component)
+		w.line("DTSTART", o.start.Format(localDateTimeFormat))
+		w.line("TZOFFSETFROM", formatOffset(o.from))
+		w.line("TZOFFSETTO", formatOffset(o.to))
+		if o.name != "" {
+			w.line("TZNAME", o.name)
+		}
+		if o.rule != "" {
+			w.line("RRULE", o.rule)
+		}
+		w.line("END", component)
+	}
+
+	w.line("END", "VTIMEZONE")
+}
+
+// observances returns the observances of the time zone of the given time in the year of that time.
+// A zone without transitions that year has a single standard observance.
+func observances(t time.Time) []observance {
+	loc := t.Location()
+	offset := func(t time.Time) int {
+		_, offset := t.In(loc).Zone()
+		return offset
+	}
+
+	// Find the days containing a transition, then its second. Zones change their offset at most once a day.
+	var transitions []time.Time
+	begin := time.Date(t.In(loc).Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	for day := begin; day.Year() == begin.Year(); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		if after := offset(next); offset(day) != after {
+			seconds := sort.Search(int(next.Sub(day)/time.Second), func(i int) bool {
+				return offset(day.Add(time.Duration(i)*time.Second)) == after
+			})
+			transitions = append(transitions, day.Add(time.Duration(seconds)*time.Second))
+		}
+	}
+
+	if len(transitions) == 0 {
+		name, offset := begin.In(loc).Zone()
+		return []observance{{
+			start: time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			from:  offset,
+			to:    offset,
+			name:  name,
+		}}
+	}
+
+	// Only a pair of transitions is a yearly daylight saving time, other changes are written as they are.
+	yearly := len(transitions) == 2
+
+	result := make([]observance, len(transitions))
+	for i, transition := range transitions {
+		from := offset(transition.Add(-time.Second))
+		name, to := transition.In(loc).Zone()
+		start := transition.Add(time.Duration(from) * time.Second).UTC()
+
+		result[i] = observance{
+			daylight: yearly && to > from,
+			start:    start,
+			from:     from,
+			to:       to,
+			name:     name,
+		}
+		if yearly {
+			result[i].rule = yearlyRule(start)
+		}
+	}
+
+	return result
+}
+
+// yearlyRule returns the RRULE repeating every year the weekday of the month of the given date,
+// e.g. the last Sunday of March.
+func yearlyRule(t time.Time) string {
+	daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	week := strconv.Itoa((t.Day()-1)/7 + 1)
+	if t.Day()+7 > daysInMonth {
+		week = "-1"
+	}
+
+	return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%s%s", t.Month(), week, weekdayCodes[t.Weekday()])
+}
+
+// formatOffset formats the given UTC offset in seconds, e.g. +0100.
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+
+	return s
+}
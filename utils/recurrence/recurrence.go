@@ -0,0 +1,311 @@
+// Package recurrence expands RRULE-style recurrence rules into occurrences.
+// Only the subset of RFC 5545 needed by the events is supported:
+// DAILY, WEEKLY and MONTHLY frequencies with INTERVAL, BYDAY, COUNT and UNTIL.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the base unit of a recurrence rule.
+type Frequency int
+
+// Supported frequencies.
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+)
+
+// frequencyNames contains the RRULE names of the frequencies.
+var frequencyNames = map[Frequency]string{
+	Daily:   "DAILY",
+	Weekly:  "WEEKLY",
+	Monthly: "MONTHLY",
+}
+
+// weekdayCodes contains the RRULE codes of the weekdays indexed by time.Weekday.
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// maxPeriods limits the number of periods to iterate, so a rule can't run forever.
+const maxPeriods = 100000
+
+// Rule describes how an event repeats.
+type Rule struct {
+	// Frequency is the base unit of the rule.
+	Frequency Frequency
+
+	// Interval is the number of frequency units between periods. Zero is the same as one.
+	Interval int
+
+	// ByDay restricts WEEKLY and MONTHLY rules to the given weekdays.
+	// An empty list means the weekday (WEEKLY) or the day of the month (MONTHLY) of the start.
+	ByDay []time.Weekday
+
+	// Count is the total number of occurrences. Zero means unlimited.
+	Count int
+
+	// Until is the inclusive upper bound of occurrences. The zero value means unlimited.
+	Until time.Time
+}
+
+// Validate returns an error if the rule can't be expanded.
+func (r Rule) Validate() error {
+	switch r.Frequency {
+	case Daily, Weekly, Monthly:
+	default:
+		return errors.New("unsupported frequency")
+	}
+
+	if r.Interval < 0 {
+		return errors.New("interval must not be negative")
+	}
+
+	if r.Count < 0 {
+		return errors.New("count must not be negative")
+	}
+
+	if r.Frequency == Daily && len(r.ByDay) > 0 {
+		return errors.New("by day is not supported by daily rules")
+	}
+
+	for _, day := range r.ByDay {
+		if day < time.Sunday || day > time.Saturday {
+			return errors.New("invalid weekday")
+		}
+	}
+
+	return nil
+}
+
+// String returns the rule in the RRULE format of RFC 5545, e.g. FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + frequencyNames[r.Frequency]}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = weekdayCodes[day]
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// ParseRule parses a rule in the RRULE format of RFC 5545. Only the supported parts are accepted,
+// except WKST which is ignored because weeks always start on Monday.
+func ParseRule(s string) (Rule, error) {
+	var rule Rule
+	for _, part := range strings.Split(s, ";") {
+		name, value := part, ""
+		if i := strings.Index(part, "="); i >= 0 {
+			name, value = part[:i], part[i+1:]
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			for frequency, frequencyName := range frequencyNames {
+				if strings.EqualFold(value, frequencyName) {
+					rule.Frequency = frequency
+				}
+			}
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(value)
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				day := indexOf(weekdayCodes, strings.ToUpper(code))
+				if day < 0 {
+					return Rule{}, fmt.Errorf("unsupported weekday '%s'", code)
+				}
+				rule.ByDay = append(rule.ByDay, time.Weekday(day))
+			}
+		case "WKST":
+		default:
+			return Rule{}, fmt.Errorf("unsupported rule part '%s'", name)
+		}
+
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid rule part '%s': %v", part, err)
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	return rule, nil
+}
+
+// Between returns the occurrences of the rule starting at start which are within [after, before).
+// The occurrences keep the wall clock time of start in its location, so they don't drift on DST changes.
+func (r Rule) Between(start, after, before time.Time) []time.Time {
+	if r.Validate() != nil || !after.Before(before) {
+		return nil
+	}
+
+	var occurrences []time.Time
+	count := 0
+	for period := 0; period < maxPeriods; period++ {
+		periodStart, candidates := r.period(start, period)
+		if !periodStart.Before(before) || (!r.Until.IsZero() && periodStart.After(r.Until)) {
+			break
+		}
+
+		for _, candidate := range candidates {
+			// The start is always the first occurrence.
+			if candidate.Before(start) {
+				continue
+			}
+
+			if !r.Until.IsZero() && candidate.After(r.Until) {
+				return occurrences
+			}
+
+			count++
+			if r.Count > 0 && count > r.Count {
+				return occurrences
+			}
+
+			if !candidate.Before(before) {
+				return occurrences
+			}
+
+			if !candidate.Before(after) {
+				occurrences = append(occurrences, candidate)
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// Includes returns true if the given time is an occurrence of the rule starting at start.
+func (r Rule) Includes(start, t time.Time) bool {
+	occurrences := r.Between(start, t, t.Add(time.Nanosecond))
+	return len(occurrences) == 1 && occurrences[0].Equal(t)
+}
+
+// period returns the beginning of the given period and its candidate occurrences in chronological order.
+func (r Rule) period(start time.Time, period int) (time.Time, []time.Time) {
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	switch r.Frequency {
+	case Daily:
+		day := at(start, start.Year(), start.Month(), start.Day()+period*interval)
+		return day, []time.Time{day}
+
+	case Weekly:
+		// Weeks start on Monday like the RFC 5545 default.
+		weekStart := at(start, start.Year(), start.Month(), start.Day()-daysSinceMonday(start.Weekday())+7*period*interval)
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+
+		offsets := make([]int, len(days))
+		for i, day := range days {
+			offsets[i] = daysSinceMonday(day)
+		}
+		sort.Ints(offsets)
+
+		var candidates []time.Time
+		for i, offset := range offsets {
+			if i > 0 && offset == offsets[i-1] {
+				continue
+			}
+			candidates = append(candidates, at(start, weekStart.Year(), weekStart.Month(), weekStart.Day()+offset))
+		}
+		return weekStart, candidates
+
+	case Monthly:
+		monthStart := at(start, start.Year(), start.Month()+time.Month(period*interval), 1)
+		if len(r.ByDay) == 0 {
+			// Months without the day of the start are skipped.
+			day := at(start, monthStart.Year(), monthStart.Month(), start.Day())
+			if day.Month() != monthStart.Month() {
+				return monthStart, nil
+			}
+			return monthStart, []time.Time{day}
+		}
+
+		var candidates []time.Time
+		for day := monthStart; day.Month() == monthStart.Month(); day = at(start, day.Year(), day.Month(), day.Day()+1) {
+			if hasWeekday(r.ByDay, day.Weekday()) {
+				candidates = append(candidates, day)
+			}
+		}
+		return monthStart, candidates
+	}
+
+	return start, nil
+}
+
+// at returns the given date with the wall clock time and location of start.
+func at(start time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+}
+
+// daysSinceMonday returns the number of days between Monday and the given weekday.
+func daysSinceMonday(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// parseUntil parses the UNTIL part of a rule, given as a UTC date-time, a floating date-time or a date.
+// Floating values are considered as UTC.
+func parseUntil(s string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("invalid date")
+}
+
+// indexOf returns the position of the given value in the list, or -1 if it's not there.
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// hasWeekday returns true if the given weekday is in the list.
+func hasWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,107 @@
+package recurrence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/recurrence"
+)
+
+var start = time.Date(2021, time.January, 31, 19, 0, 0, 0, time.UTC) // Sunday
+
+func TestBetween(t *testing.T) {
+	t.Run("daily with interval", func(t *testing.T) {
+		rule := recurrence.Rule{Frequency: recurrence.Daily, Interval: 2}
+
+		requireDays(t, rule.Between(start, start, start.AddDate(0, 0, 7)), "2021-01-31", "2021-02-02", "2021-02-04", "2021-02-06")
+	})
+
+	t.Run("weekly by day", func(t *testing.T) {
+		rule := recurrence.Rule{Frequency: recurrence.Weekly, ByDay: []time.Weekday{time.Tuesday, time.Sunday}}
+
+		requireDays(t, rule.Between(start, start, start.AddDate(0, 0, 14)), "2021-01-31", "2021-02-02", "2021-02-07", "2021-02-09")
+	})
+
+	t.Run("monthly skips short months", func(t *testing.T) {
+		rule := recurrence.Rule{Frequency: recurrence.Monthly}
+
+		requireDays(t, rule.Between(start, start, start.AddDate(0, 5, 0)), "2021-01-31", "2021-03-31", "2021-05-31")
+	})
+
+	t.Run("count includes occurrences before the window", func(t *testing.T) {
+		rule := recurrence.Rule{Frequency: recurrence.Daily, Count: 3}
+
+		requireDays(t, rule.Between(start, start.AddDate(0, 0, 1), start.AddDate(1, 0, 0)), "2021-02-01", "2021-02-02")
+	})
+
+	t.Run("until is inclusive", func(t *testing.T) {
+		rule := recurrence.Rule{Frequency: recurrence.Weekly, Until: start.AddDate(0, 0, 14)}
+
+		requireDays(t, rule.Between(start, start, start.AddDate(1, 0, 0)), "2021-01-31", "2021-02-07", "2021-02-14")
+	})
+
+	t.Run("wall clock is kept across DST changes", func(t *testing.T) {
+		location, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		localStart := time.Date(2021, time.March, 7, 19, 0, 0, 0, location)
+		rule := recurrence.Rule{Frequency: recurrence.Weekly}
+
+		occurrences := rule.Between(localStart, localStart, localStart.AddDate(0, 0, 14))
+		require.Len(t, occurrences, 2)
+		require.Equal(t, 19, occurrences[1].Hour())
+		require.Equal(t, 6*24*time.Hour+23*time.Hour, occurrences[1].Sub(occurrences[0]))
+	})
+}
+
+func TestIncludes(t *testing.T) {
+	rule := recurrence.Rule{Frequency: recurrence.Weekly}
+
+	require.True(t, rule.Includes(start, start.AddDate(0, 0, 7)))
+	require.False(t, rule.Includes(start, start.AddDate(0, 0, 8)))
+	require.False(t, rule.Includes(start, start.AddDate(0, 0, -7)))
+}
+
+func TestString(t *testing.T) {
+	rule := recurrence.Rule{
+		Frequency: recurrence.Weekly,
+		Interval:  2,
+		ByDay:     []time.Weekday{time.Monday, time.Wednesday},
+		Count:     10,
+	}
+	require.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10", rule.String())
+
+	rule = recurrence.Rule{Frequency: recurrence.Daily, Until: start}
+	require.Equal(t, "FREQ=DAILY;UNTIL=20210131T190000Z", rule.String())
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := recurrence.ParseRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10;WKST=MO")
+	require.NoError(t, err)
+	require.Equal(t, recurrence.Rule{
+		Frequency: recurrence.Weekly,
+		Interval:  2,
+		ByDay:     []time.Weekday{time.Monday, time.Wednesday},
+		Count:     10,
+	}, rule)
+
+	rule, err = recurrence.ParseRule("FREQ=DAILY;UNTIL=20210131T190000Z")
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=DAILY;UNTIL=20210131T190000Z", rule.String())
+
+	for _, s := range []string{"FREQ=YEARLY", "FREQ=MONTHLY;BYMONTHDAY=1", "FREQ=WEEKLY;BYDAY=1MO", "FREQ=DAILY;COUNT=x"} {
+		_, err := recurrence.ParseRule(s)
+		require.Error(t, err, s)
+	}
+}
+
+func requireDays(t *testing.T, occurrences []time.Time, expected ...string) {
+	days := make([]string, len(occurrences))
+	for i, occurrence := range occurrences {
+		days[i] = occurrence.Format("2006-01-02")
+	}
+
+	require.Equal(t, expected, days)
+}
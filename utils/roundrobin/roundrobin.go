@@ -0,0 +1,65 @@
+// Package roundrobin schedules round-robin tournaments, where every team plays every other team once per cycle.
+// The rounds are built with the circle method, and the teams alternate between home and away matches.
+package roundrobin
+
+// Match is a match between two teams, given by their position in the list of teams.
+type Match struct {
+	Home, Away int
+}
+
+// Rounds returns the rounds of a round-robin tournament between the given number of teams.
+// Every team plays at most once per round, and sits a round out if the number of teams is odd.
+// If double is true, the rounds are played a second time with the home and away teams swapped.
+// Returns no round if there are less than two teams.
+func Rounds(teams int, double bool) [][]Match {
+	if teams < 2 {
+		return nil
+	}
+
+	// An odd number of teams gets a ghost team, its opponent sits the round out.
+	slots := teams
+	if slots%2 == 1 {
+		slots++
+	}
+
+	// The first slot is fixed and the others rotate around it.
+	circle := make([]int, slots)
+	for i := range circle {
+		circle[i] = i
+	}
+
+	rounds := make([][]Match, 0, 2*(slots-1))
+	for r := 0; r < slots-1; r++ {
+		var round []Match
+		for i := 0; i < slots/2; i++ {
+			home, away := circle[i], circle[slots-1-i]
+			if home >= teams || away >= teams {
+				continue
+			}
+
+			// The fixed team alternates every round, the others alternate with their position in the circle.
+			if i == 0 && r%2 == 1 || i > 0 && i%2 == 1 {
+				home, away = away, home
+			}
+			round = append(round, Match{Home: home, Away: away})
+		}
+		rounds = append(rounds, round)
+
+		// Rotate all slots but the first one clockwise.
+		last := circle[slots-1]
+		copy(circle[2:], circle[1:slots-1])
+		circle[1] = last
+	}
+
+	if double {
+		for _, round := range rounds[:slots-1] {
+			reversed := make([]Match, len(round))
+			for i, match := range round {
+				reversed[i] = Match{Home: match.Away, Away: match.Home}
+			}
+			rounds = append(rounds, reversed)
+		}
+	}
+
+	return rounds
+}
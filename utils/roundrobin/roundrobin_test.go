@@ -0,0 +1,69 @@
+package roundrobin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/roundrobin"
+)
+
+func TestRounds(t *testing.T) {
+	require.Empty(t, roundrobin.Rounds(1, true))
+
+	for teams := 2; teams <= 9; teams++ {
+		rounds := roundrobin.Rounds(teams, false)
+
+		expected := teams - 1
+		if teams%2 == 1 {
+			expected = teams
+		}
+		require.Len(t, rounds, expected, "teams: %d", teams)
+
+		// Every pair of teams meets exactly once, and no team plays twice in a round.
+		pairs := make(map[[2]int]int)
+		homes := make([]int, teams)
+		for _, round := range rounds {
+			playing := make(map[int]bool)
+			for _, match := range round {
+				require.NotEqual(t, match.Home, match.Away)
+				require.False(t, playing[match.Home] || playing[match.Away])
+				playing[match.Home], playing[match.Away] = true, true
+
+				a, b := match.Home, match.Away
+				if a > b {
+					a, b = b, a
+				}
+				pairs[[2]int{a, b}]++
+				homes[match.Home]++
+			}
+			require.Len(t, round, teams/2)
+		}
+		require.Len(t, pairs, teams*(teams-1)/2)
+		for _, count := range pairs {
+			require.Equal(t, 1, count)
+		}
+
+		// The home matches are shared fairly.
+		for _, count := range homes {
+			require.InDelta(t, float64(teams-1)/2, float64(count), 1)
+		}
+	}
+}
+
+func TestRoundsDouble(t *testing.T) {
+	rounds := roundrobin.Rounds(4, true)
+	require.Len(t, rounds, 6)
+
+	matches := make(map[roundrobin.Match]int)
+	for _, round := range rounds {
+		for _, match := range round {
+			matches[match]++
+		}
+	}
+	require.Len(t, matches, 12)
+
+	for i, match := range rounds[0] {
+		require.Equal(t, roundrobin.Match{Home: match.Away, Away: match.Home}, rounds[3][i])
+	}
+}
@@ -6,4 +6,22 @@ package rpc
 const (
 	// AccountServiceName is the registry name of the account-svc service
 	AccountServiceName = "go-micro-boilerplate.account-svc"
+
+	// EventServiceName is the registry name of the event-svc service
+	EventServiceName = "go-micro-boilerplate.event-svc"
+
+	// RestAPIServiceName is the registry name of the rest-api-svc service
+	RestAPIServiceName = "go-micro-boilerplate.rest-api-svc"
+)
+
+// Topics names.
+const (
+	// AttendeePromotedTopic is the broker topic of users promoted from the waitlist of an event
+	AttendeePromotedTopic = "go-micro-boilerplate.event-svc.attendee-promoted"
+
+	// EventDecidedTopic is the broker topic of events confirmed or cancelled at their decision deadline
+	EventDecidedTopic = "go-micro-boilerplate.event-svc.event-decided"
+
+	// EventReminderTopic is the broker topic of the reminders sent to the attendees of upcoming events
+	EventReminderTopic = "go-micro-boilerplate.event-svc.event-reminder"
 )
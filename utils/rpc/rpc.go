@@ -19,6 +19,17 @@ func ErrAbortedf(format string, args ...interface{}) *status.Status {
 	return Errf(ErrAbortedCode, format, args...)
 }
 
+// ErrFailedPreconditionCode is the integer corresponding to the
+// error-failed-precondition status in the Google rpc/code library
+var ErrFailedPreconditionCode = int32(statuscode.Code_FAILED_PRECONDITION)
+
+// ErrFailedPreconditionf returns a Google-style RPC status containing a
+// a "failed precondition" error with the message constructed by formatting the
+// given format string with the given varargs
+func ErrFailedPreconditionf(format string, args ...interface{}) *status.Status {
+	return Errf(ErrFailedPreconditionCode, format, args...)
+}
+
 // Errf returns a Google-style RPC status containing the given error
 // code with the message constructed by formatting the given format
 // string with the given varargs
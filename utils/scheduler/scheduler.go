@@ -0,0 +1,141 @@
+// Package scheduler runs background jobs at a fixed interval, e.g. the periodic sweeps of a service.
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultInterval = time.Minute
+)
+
+// Job is the type of the functions run by the scheduler, now is the time of the tick.
+type Job func(ctx context.Context, now time.Time) error
+
+// Options defines the options for the scheduler
+type Options struct {
+	// If set, the jobs run at this interval. Defaults to one minute otherwise
+	Interval time.Duration
+
+	// Log receives the errors of the jobs
+	Log logrus.FieldLogger
+}
+
+// Scheduler runs its jobs in the background, once when it starts and then at every interval.
+// The jobs run one after another in the order they were added, so a slow job delays the next tick
+// instead of overlapping itself.
+type Scheduler struct {
+	interval time.Duration
+	log      logrus.FieldLogger
+
+	mu     sync.Mutex
+	names  []string
+	jobs   []Job
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a new scheduler, it doesn't run any job until Start is called.
+func New(opts *Options) *Scheduler {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	log := opts.Log
+	if log == nil {
+		log = logrus.New()
+	}
+
+	return &Scheduler{
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Add adds a job to the scheduler, the name identifies it in the logs.
+// Jobs added after Start are picked up at the next tick.
+func (s *Scheduler) Add(name string, job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.names = append(s.names, name)
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs the jobs in a new goroutine until Stop is called. Starting a running scheduler has no effect.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go s.loop(ctx, s.done)
+}
+
+// Stop stops the scheduler and waits for the running jobs to return. Stopping a stopped scheduler has no effect.
+// The signature matches the callbacks of micro.BeforeStop and micro.AfterStop.
+func (s *Scheduler) Stop() error {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.cancel, s.done = nil, nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	<-done
+
+	return nil
+}
+
+// loop runs the jobs at once and then at every tick until the context is cancelled.
+func (s *Scheduler) loop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.run(ctx, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.run(ctx, now)
+		}
+	}
+}
+
+// run runs every job once and logs their errors.
+func (s *Scheduler) run(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	names, jobs := s.names, s.jobs
+	s.mu.Unlock()
+
+	for i, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := job(ctx, now); err != nil {
+			s.log.WithError(err).Errorf("scheduled job '%s' failed", names[i])
+		}
+	}
+}
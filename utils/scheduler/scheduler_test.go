@@ -0,0 +1,46 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/scheduler"
+)
+
+func TestScheduler(t *testing.T) {
+	log, hook := test.NewNullLogger()
+	s := scheduler.New(&scheduler.Options{Interval: 10 * time.Millisecond, Log: log})
+
+	var runs int32
+	s.Add("count", func(ctx context.Context, now time.Time) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+	s.Add("fail", func(ctx context.Context, now time.Time) error {
+		return errors.New("boom")
+	})
+
+	require.NoError(t, s.Stop())
+
+	s.Start()
+	s.Start()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&runs) >= 3 }, time.Second, time.Millisecond)
+	require.NoError(t, s.Stop())
+	require.NoError(t, s.Stop())
+
+	stopped := atomic.LoadInt32(&runs)
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, stopped, atomic.LoadInt32(&runs))
+
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	require.Equal(t, logrus.ErrorLevel, entry.Level)
+	require.Equal(t, "scheduled job 'fail' failed", entry.Message)
+}
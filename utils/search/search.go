@@ -0,0 +1,235 @@
+// Package search is an in-memory full-text index: words are case-folded and stemmed, matched by prefix too,
+// and the documents are ranked by the relevance of their terms.
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// saturation bounds the weight of the repeated terms of a document, the same way as the k1 parameter of BM25.
+	saturation = 1.2
+
+	// prefixWeight is the weight of a prefix match relative to a match of the whole term.
+	prefixWeight = 0.5
+
+	// minPrefixLength is the length a query term needs to match the longer terms it's a prefix of.
+	minPrefixLength = 2
+)
+
+// stopWords are the words too common to be indexed.
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "at": {}, "by": {}, "for": {}, "in": {}, "of": {},
+	"on": {}, "or": {}, "the": {}, "to": {}, "with": {},
+}
+
+// Field is a text of a document with the weight of its terms in the ranking, e.g. a title weighs more than a body.
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// Result is a document matching a query with its relevance.
+type Result struct {
+	ID    string
+	Score float64
+}
+
+// Index is an inverted index of documents, it's updated document by document and is safe for concurrent use.
+// The terms of the documents are case-folded and stemmed, and the words are also indexed as they are
+// so the queries can match their prefix.
+type Index struct {
+	mu sync.RWMutex
+
+	// postings contains the weighted frequency of every term in every document containing it.
+	postings map[string]map[string]float64
+
+	// terms contains the indexed terms in ascending order, to find the terms starting with a prefix.
+	terms []string
+
+	// docs contains the terms of every document, to remove it from the postings.
+	docs map[string][]string
+}
+
+// New creates a new empty index.
+func New() *Index {
+	return &Index{
+		postings: make(map[string]map[string]float64),
+		docs:     make(map[string][]string),
+	}
+}
+
+// Put indexes the document with the given ID, replacing its previous version if any.
+// Fields without a positive weight are ignored.
+func (x *Index) Put(id string, fields ...Field) {
+	frequencies := make(map[string]float64)
+	for _, field := range fields {
+		if field.Weight <= 0 {
+			continue
+		}
+
+		for _, word := range Tokenize(field.Text) {
+			stem := Stem(word)
+			frequencies[stem] += field.Weight
+			if word != stem {
+				frequencies[word] += field.Weight
+			}
+		}
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(id)
+	if len(frequencies) == 0 {
+		return
+	}
+
+	terms := make([]string, 0, len(frequencies))
+	for term, frequency := range frequencies {
+		docs, ok := x.postings[term]
+		if !ok {
+			docs = make(map[string]float64)
+			x.postings[term] = docs
+			x.insertTerm(term)
+		}
+
+		docs[id] = frequency
+		terms = append(terms, term)
+	}
+
+	x.docs[id] = terms
+}
+
+// Delete removes the document with the given ID from the index.
+func (x *Index) Delete(id string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(id)
+}
+
+// Search returns the documents matching every term of the query, the most relevant first.
+// A query term matches the documents containing the same stem, and with less relevance
+// the documents containing a word it's a prefix of. Rare terms weigh more than common ones.
+func (x *Index) Search(query string) []Result {
+	words := Tokenize(query)
+	if len(words) == 0 {
+		return nil
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var scores map[string]float64
+	for _, word := range words {
+		matches := x.match(word)
+
+		// Only the documents matching every term are kept.
+		if scores == nil {
+			scores = matches
+		} else {
+			for id, score := range scores {
+				if match, ok := matches[id]; ok {
+					scores[id] = score + match
+				} else {
+					delete(scores, id)
+				}
+			}
+		}
+
+		if len(scores) == 0 {
+			return nil
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for id, score := range scores {
+		results = append(results, Result{ID: id, Score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+
+	return results
+}
+
+// Tokenize splits the given text into lowercase words, skipping the punctuation and the stop words.
+func Tokenize(text string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if _, ok := stopWords[word]; !ok {
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// match returns the score of every document matching the given query word. The read lock must be held by the caller.
+func (x *Index) match(word string) map[string]float64 {
+	scores := make(map[string]float64)
+	add := func(term string, weight float64) {
+		docs := x.postings[term]
+		idf := math.Log(1 + float64(len(x.docs))/float64(len(docs)))
+		for id, frequency := range docs {
+			score := weight * idf * frequency * (saturation + 1) / (frequency + saturation)
+			if score > scores[id] {
+				scores[id] = score
+			}
+		}
+	}
+
+	if len([]rune(word)) >= minPrefixLength {
+		for i := sort.SearchStrings(x.terms, word); i < len(x.terms) && strings.HasPrefix(x.terms[i], word); i++ {
+			if x.terms[i] != word {
+				add(x.terms[i], prefixWeight)
+			}
+		}
+	}
+
+	if stem := Stem(word); x.postings[stem] != nil {
+		add(stem, 1)
+	}
+
+	return scores
+}
+
+// remove removes the document with the given ID from the postings. The lock must be held by the caller.
+func (x *Index) remove(id string) {
+	for _, term := range x.docs[id] {
+		docs := x.postings[term]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(x.postings, term)
+			x.deleteTerm(term)
+		}
+	}
+
+	delete(x.docs, id)
+}
+
+// insertTerm adds the given term to the sorted terms. The lock must be held by the caller.
+func (x *Index) insertTerm(term string) {
+	i := sort.SearchStrings(x.terms, term)
+	x.terms = append(x.terms, "")
+	copy(x.terms[i+1:], x.terms[i:])
+	x.terms[i] = term
+}
+
+// deleteTerm removes the given term from the sorted terms. The lock must be held by the caller.
+func (x *Index) deleteTerm(term string) {
+	if i := sort.SearchStrings(x.terms, term); i < len(x.terms) && x.terms[i] == term {
+		x.terms = append(x.terms[:i], x.terms[i+1:]...)
+	}
+}
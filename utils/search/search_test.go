@@ -0,0 +1,79 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/search"
+)
+
+func TestStem(t *testing.T) {
+	for word, stem := range map[string]string{
+		"matches":  "match",
+		"match":    "match",
+		"boxes":    "box",
+		"games":    "game",
+		"parties":  "parti",
+		"party":    "parti",
+		"hiking":   "hike",
+		"hike":     "hike",
+		"running":  "run",
+		"played":   "plai",
+		"agreed":   "agree",
+		"class":    "class",
+		"opening":  "open",
+		"soccer":   "soccer",
+		"is":       "is",
+		"football": "football",
+	} {
+		require.Equal(t, stem, search.Stem(word), word)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"5v5", "soccer", "évora", "park"}, search.Tokenize("5v5 Soccer at the ÉVORA-park!"))
+	require.Empty(t, search.Tokenize(" the, of "))
+}
+
+func TestIndex(t *testing.T) {
+	x := search.New()
+	x.Put("1", search.Field{Text: "Sunday soccer", Weight: 3}, search.Field{Text: "Friendly matches in the park", Weight: 1})
+	x.Put("2", search.Field{Text: "Park run", Weight: 3}, search.Field{Text: "Running with friends, then soccer", Weight: 1})
+	x.Put("3", search.Field{Text: "Board games", Weight: 3})
+
+	ids := func(results []search.Result) []string {
+		var ids []string
+		for _, r := range results {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+
+	// Name matches rank higher than description matches.
+	require.Equal(t, []string{"1", "2"}, ids(x.Search("SOCCER")))
+	require.Equal(t, []string{"2", "1"}, ids(x.Search("park")))
+
+	// Stems, prefixes and every term of the query must match.
+	require.Equal(t, []string{"1"}, ids(x.Search("match")))
+	require.Equal(t, []string{"2"}, ids(x.Search("runs")))
+	require.Equal(t, []string{"1", "2"}, ids(x.Search("socc")))
+	require.Equal(t, []string{"2"}, ids(x.Search("soccer runn")))
+	require.Empty(t, x.Search("soccer board"))
+	require.Empty(t, x.Search("s"))
+	require.Empty(t, x.Search("the"))
+
+	// Exact matches rank higher than prefix matches.
+	x.Put("4", search.Field{Text: "Gamers meetup", Weight: 3})
+	require.Equal(t, []string{"3", "4"}, ids(x.Search("game")))
+
+	// The index is updated document by document.
+	x.Put("1", search.Field{Text: "Sunday tennis", Weight: 3})
+	require.Equal(t, []string{"2"}, ids(x.Search("soccer")))
+	require.Equal(t, []string{"1"}, ids(x.Search("tennis")))
+
+	x.Delete("2")
+	x.Delete("2")
+	require.Empty(t, x.Search("soccer"))
+	require.Empty(t, x.Search("park"))
+}
@@ -0,0 +1,114 @@
+package search
+
+import "strings"
+
+// Stem reduces a lowercase English word to its stem, so the inflections of a word match each other,
+// e.g. "matches" and "match" or "hiking" and "hike". It implements a variant of the first step of the Porter
+// stemmer, which strips the plurals and the -ed and -ing suffixes and turns a final y into i,
+// and leaves the other words untouched.
+func Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+
+	// Plurals.
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ies"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "xes"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ss"):
+	case strings.HasSuffix(word, "s"):
+		word = word[:len(word)-1]
+	}
+
+	// Past participles and gerunds.
+	switch {
+	case strings.HasSuffix(word, "eed"):
+		if measure(word[:len(word)-3]) > 0 {
+			word = word[:len(word)-1]
+		}
+	case strings.HasSuffix(word, "ed") && hasVowel(word[:len(word)-2]):
+		word = restore(word[:len(word)-2])
+	case strings.HasSuffix(word, "ing") && hasVowel(word[:len(word)-3]):
+		word = restore(word[:len(word)-3])
+	}
+
+	// Final y, so "party" matches "parties".
+	if strings.HasSuffix(word, "y") && hasVowel(word[:len(word)-1]) {
+		word = word[:len(word)-1] + "i"
+	}
+
+	return word
+}
+
+// restore fixes the stem left by stripping -ed or -ing, e.g. "hop" from "hopping" or "hike" from "hiking".
+func restore(stem string) string {
+	switch {
+	case strings.HasSuffix(stem, "at"), strings.HasSuffix(stem, "bl"), strings.HasSuffix(stem, "iz"):
+		return stem + "e"
+	case doubleConsonant(stem):
+		if last := stem[len(stem)-1]; last != 'l' && last != 's' && last != 'z' {
+			return stem[:len(stem)-1]
+		}
+	case measure(stem) == 1 && endsCVC(stem):
+		return stem + "e"
+	}
+
+	return stem
+}
+
+// consonant reports whether the letter at position i of the word is a consonant.
+// The letter y is a consonant at the start of the word or after a vowel.
+func consonant(word string, i int) bool {
+	switch word[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !consonant(word, i-1)
+	default:
+		return true
+	}
+}
+
+// measure returns the number of vowel-consonant sequences of the word.
+func measure(word string) int {
+	m := 0
+	for i := 1; i < len(word); i++ {
+		if consonant(word, i) && !consonant(word, i-1) {
+			m++
+		}
+	}
+
+	return m
+}
+
+// hasVowel reports whether the word contains a vowel.
+func hasVowel(word string) bool {
+	for i := range word {
+		if !consonant(word, i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// doubleConsonant reports whether the word ends with the same consonant twice.
+func doubleConsonant(word string) bool {
+	n := len(word)
+	return n >= 2 && word[n-1] == word[n-2] && consonant(word, n-1)
+}
+
+// endsCVC reports whether the word ends with a consonant, a vowel and a consonant other than w, x and y.
+func endsCVC(word string) bool {
+	n := len(word)
+	if n < 3 || !consonant(word, n-3) || consonant(word, n-2) || !consonant(word, n-1) {
+		return false
+	}
+
+	last := word[n-1]
+	return last != 'w' && last != 'x' && last != 'y'
+}
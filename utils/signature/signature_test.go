@@ -0,0 +1,19 @@
+package signature_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/signature"
+)
+
+func TestVerify(t *testing.T) {
+	sig := signature.Sign("secret", "user", "calendar")
+
+	require.True(t, signature.Verify("secret", sig, "user", "calendar"))
+	require.False(t, signature.Verify("other", sig, "user", "calendar"))
+	require.False(t, signature.Verify("secret", sig, "user", "calendar", ""))
+	require.False(t, signature.Verify("secret", sig, "usercalendar"))
+	require.False(t, signature.Verify("secret", "", "user", "calendar"))
+}
@@ -0,0 +1,310 @@
+// Package teams splits players into teams of balanced total skill.
+// Groups of players can be kept together in the same team or apart in different teams.
+package teams
+
+import (
+	"errors"
+	"sort"
+)
+
+// maxRounds limits the number of improvement rounds, so balancing always ends quickly.
+const maxRounds = 1000
+
+// Errors returned when players can't be split into teams.
+var (
+	// ErrTeamCount is returned when the number of teams is lower than two or greater than the number of players.
+	ErrTeamCount = errors.New("team count must be between 2 and the number of players")
+
+	// ErrUnknownPlayer is returned when a constraint refers to a player which isn't split.
+	ErrUnknownPlayer = errors.New("constraint refers to an unknown player")
+
+	// ErrConflict is returned when two players must be kept both together and apart.
+	ErrConflict = errors.New("players can't be kept both together and apart")
+
+	// ErrUnsatisfiable is returned when no split respects the constraints.
+	ErrUnsatisfiable = errors.New("constraints can't be satisfied")
+)
+
+// Player is a player to put in a team.
+type Player struct {
+	ID    string
+	Skill float64
+}
+
+// Constraints restricts how players are split.
+type Constraints struct {
+	// Together lists the groups of players which must play in the same team.
+	Together [][]string
+
+	// Apart lists the groups of players which must all play in different teams.
+	Apart [][]string
+}
+
+// group is a set of players which are kept together.
+type group struct {
+	members []int
+	skill   float64
+}
+
+// Balance splits the players into n teams which minimize the difference in total skill between the teams.
+// Team sizes differ by at most one player, unless the groups kept together prevent it.
+// Players keep their order within a team. The split is deterministic, so the same input gives the same teams.
+func Balance(players []Player, n int, constraints Constraints) ([][]Player, error) {
+	if n < 2 || n > len(players) {
+		return nil, ErrTeamCount
+	}
+
+	index := make(map[string]int, len(players))
+	for i, player := range players {
+		index[player.ID] = i
+	}
+
+	groups, groupOf, err := groupPlayers(players, index, constraints.Together)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, err := conflictingGroups(index, groupOf, constraints.Apart)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := assign(groups, conflicts, n, (len(players)+n-1)/n)
+	if err != nil {
+		return nil, err
+	}
+
+	improve(groups, conflicts, assignment, n)
+
+	teams := make([][]Player, n)
+	for i := range players {
+		team := assignment[groupOf[i]]
+		teams[team] = append(teams[team], players[i])
+	}
+
+	return teams, nil
+}
+
+// groupPlayers merges the players kept together into groups.
+// Returns the groups and the group of every player.
+func groupPlayers(players []Player, index map[string]int, together [][]string) ([]group, []int, error) {
+	// Union-find of the players, the root of a set is its smallest player index.
+	parent := make([]int, len(players))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for _, ids := range together {
+		for _, id := range ids {
+			i, ok := index[id]
+			if !ok {
+				return nil, nil, ErrUnknownPlayer
+			}
+
+			a, b := find(i), find(index[ids[0]])
+			if a > b {
+				a, b = b, a
+			}
+			parent[b] = a
+		}
+	}
+
+	var groups []group
+	groupOf := make([]int, len(players))
+	roots := make(map[int]int)
+	for i, player := range players {
+		root := find(i)
+		g, ok := roots[root]
+		if !ok {
+			g = len(groups)
+			roots[root] = g
+			groups = append(groups, group{})
+		}
+
+		groups[g].members = append(groups[g].members, i)
+		groups[g].skill += player.Skill
+		groupOf[i] = g
+	}
+
+	return groups, groupOf, nil
+}
+
+// conflictingGroups returns the pairs of groups which must be in different teams.
+func conflictingGroups(index map[string]int, groupOf []int, apart [][]string) (map[[2]int]bool, error) {
+	conflicts := make(map[[2]int]bool)
+	for _, ids := range apart {
+		for i, a := range ids {
+			for _, b := range ids[i+1:] {
+				ia, ok := index[a]
+				if !ok {
+					return nil, ErrUnknownPlayer
+				}
+
+				ib, ok := index[b]
+				if !ok {
+					return nil, ErrUnknownPlayer
+				}
+
+				ga, gb := groupOf[ia], groupOf[ib]
+				if ga == gb {
+					return nil, ErrConflict
+				}
+
+				conflicts[[2]int{ga, gb}] = true
+				conflicts[[2]int{gb, ga}] = true
+			}
+		}
+	}
+
+	return conflicts, nil
+}
+
+// assign greedily gives every group, the largest and the strongest first, to the weakest team with room for it.
+// Teams are filled up to the given size if possible, otherwise the smallest team takes the group.
+// Returns the team of every group.
+func assign(groups []group, conflicts map[[2]int]bool, n int, size int) ([]int, error) {
+	order := make([]int, len(groups))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := groups[order[i]], groups[order[j]]
+		if len(a.members) != len(b.members) {
+			return len(a.members) > len(b.members)
+		}
+		return a.skill > b.skill
+	})
+
+	assignment := make([]int, len(groups))
+	skills := make([]float64, n)
+	sizes := make([]int, n)
+	teamGroups := make([][]int, n)
+	for _, g := range order {
+		best := -1
+		for _, limit := range []bool{true, false} {
+			for team := 0; team < n; team++ {
+				if limit && sizes[team]+len(groups[g].members) > size {
+					continue
+				}
+
+				if conflictsWith(conflicts, g, teamGroups[team]) {
+					continue
+				}
+
+				if best < 0 || better(skills, sizes, team, best, !limit) {
+					best = team
+				}
+			}
+
+			if best >= 0 {
+				break
+			}
+		}
+
+		if best < 0 {
+			return nil, ErrUnsatisfiable
+		}
+
+		assignment[g] = best
+		skills[best] += groups[g].skill
+		sizes[best] += len(groups[g].members)
+		teamGroups[best] = append(teamGroups[best], g)
+	}
+
+	return assignment, nil
+}
+
+// better returns true if the team a is a better choice than the team b for the next group:
+// the weakest team, then the smallest one. The smallest team is preferred first if bySize is true.
+func better(skills []float64, sizes []int, a, b int, bySize bool) bool {
+	if bySize && sizes[a] != sizes[b] {
+		return sizes[a] < sizes[b]
+	}
+
+	if skills[a] != skills[b] {
+		return skills[a] < skills[b]
+	}
+
+	return sizes[a] < sizes[b]
+}
+
+// improve swaps groups of the same size between teams while it narrows the skill gap between them.
+func improve(groups []group, conflicts map[[2]int]bool, assignment []int, n int) {
+	skills := make([]float64, n)
+	for g, team := range assignment {
+		skills[team] += groups[g].skill
+	}
+
+	for round := 0; round < maxRounds; round++ {
+		// Find the swap which reduces the most the sum of the squared team skills,
+		// which is the lowest when the total skill is evenly spread.
+		bestGain, bestA, bestB := 0.0, -1, -1
+		for a := range groups {
+			for b := a + 1; b < len(groups); b++ {
+				ta, tb := assignment[a], assignment[b]
+				if ta == tb || len(groups[a].members) != len(groups[b].members) {
+					continue
+				}
+
+				d := groups[a].skill - groups[b].skill
+				gain := skills[ta]*skills[ta] + skills[tb]*skills[tb] -
+					(skills[ta]-d)*(skills[ta]-d) - (skills[tb]+d)*(skills[tb]+d)
+				if gain <= 1e-9 || gain <= bestGain {
+					continue
+				}
+
+				if swapConflicts(conflicts, assignment, a, b) {
+					continue
+				}
+
+				bestGain, bestA, bestB = gain, a, b
+			}
+		}
+
+		if bestA < 0 {
+			return
+		}
+
+		ta, tb := assignment[bestA], assignment[bestB]
+		d := groups[bestA].skill - groups[bestB].skill
+		skills[ta] -= d
+		skills[tb] += d
+		assignment[bestA], assignment[bestB] = tb, ta
+	}
+}
+
+// conflictsWith returns true if the given group must be apart from any of the given groups.
+func conflictsWith(conflicts map[[2]int]bool, g int, others []int) bool {
+	for _, other := range others {
+		if conflicts[[2]int{g, other}] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// swapConflicts returns true if swapping the teams of the groups a and b breaks a constraint.
+func swapConflicts(conflicts map[[2]int]bool, assignment []int, a, b int) bool {
+	ta, tb := assignment[a], assignment[b]
+	for g, team := range assignment {
+		if team == tb && g != b && conflicts[[2]int{a, g}] {
+			return true
+		}
+
+		if team == ta && g != a && conflicts[[2]int{b, g}] {
+			return true
+		}
+	}
+
+	return false
+}
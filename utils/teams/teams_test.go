@@ -0,0 +1,120 @@
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/marboga/gametimehero/utils/teams"
+)
+
+func players(skills ...float64) []teams.Player {
+	result := make([]teams.Player, len(skills))
+	for i, skill := range skills {
+		result[i] = teams.Player{ID: string(rune('a' + i)), Skill: skill}
+	}
+	return result
+}
+
+func totals(split [][]teams.Player) []float64 {
+	result := make([]float64, len(split))
+	for i, team := range split {
+		for _, player := range team {
+			result[i] += player.Skill
+		}
+	}
+	return result
+}
+
+func teamOf(split [][]teams.Player, id string) int {
+	for i, team := range split {
+		for _, player := range team {
+			if player.ID == id {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func TestBalance(t *testing.T) {
+	split, err := teams.Balance(players(9, 8, 7, 4, 3, 1), 2, teams.Constraints{})
+	require.NoError(t, err)
+	require.Len(t, split, 2)
+	require.Len(t, split[0], 3)
+	require.Len(t, split[1], 3)
+	require.Equal(t, []float64{16, 16}, totals(split))
+
+	split, err = teams.Balance(players(5, 5, 5, 5, 5, 5, 5), 3, teams.Constraints{})
+	require.NoError(t, err)
+	for _, team := range split {
+		require.True(t, len(team) == 2 || len(team) == 3)
+	}
+}
+
+func TestBalanceIsDeterministic(t *testing.T) {
+	first, err := teams.Balance(players(4, 8, 1, 6, 3, 9, 2, 7), 2, teams.Constraints{})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		split, err := teams.Balance(players(4, 8, 1, 6, 3, 9, 2, 7), 2, teams.Constraints{})
+		require.NoError(t, err)
+		require.Equal(t, first, split)
+	}
+}
+
+func TestBalanceTogether(t *testing.T) {
+	split, err := teams.Balance(players(9, 8, 7, 3, 2, 1), 2, teams.Constraints{
+		Together: [][]string{{"a", "b"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, teamOf(split, "a"), teamOf(split, "b"))
+	require.Equal(t, []float64{18, 12}, totals(split))
+}
+
+func TestBalanceApart(t *testing.T) {
+	split, err := teams.Balance(players(1, 1, 9, 9), 2, teams.Constraints{
+		Apart: [][]string{{"c", "d"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, teamOf(split, "c"), teamOf(split, "d"))
+
+	split, err = teams.Balance(players(9, 1, 1, 1, 1, 9), 3, teams.Constraints{
+		Together: [][]string{{"a", "b"}},
+		Apart:    [][]string{{"a", "e", "f"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, teamOf(split, "a"), teamOf(split, "b"))
+	require.NotEqual(t, teamOf(split, "a"), teamOf(split, "e"))
+	require.NotEqual(t, teamOf(split, "a"), teamOf(split, "f"))
+	require.NotEqual(t, teamOf(split, "e"), teamOf(split, "f"))
+}
+
+func TestBalanceErrors(t *testing.T) {
+	_, err := teams.Balance(players(1, 2, 3), 1, teams.Constraints{})
+	require.Equal(t, teams.ErrTeamCount, err)
+
+	_, err = teams.Balance(players(1, 2, 3), 4, teams.Constraints{})
+	require.Equal(t, teams.ErrTeamCount, err)
+
+	_, err = teams.Balance(players(1, 2, 3), 2, teams.Constraints{Together: [][]string{{"a", "z"}}})
+	require.Equal(t, teams.ErrUnknownPlayer, err)
+
+	_, err = teams.Balance(players(1, 2, 3), 2, teams.Constraints{
+		Together: [][]string{{"a", "b"}},
+		Apart:    [][]string{{"b", "a"}},
+	})
+	require.Equal(t, teams.ErrConflict, err)
+
+	_, err = teams.Balance(players(1, 2, 3), 2, teams.Constraints{Apart: [][]string{{"a", "b", "c"}}})
+	require.Equal(t, teams.ErrUnsatisfiable, err)
+}
+
+func TestBalanceOddTotal(t *testing.T) {
+	// The total is odd, so the best split has a gap of one.
+	split, err := teams.Balance(players(10, 9, 8, 6, 4, 3, 2, 1), 2, teams.Constraints{})
+	require.NoError(t, err)
+
+	gap := totals(split)[0] - totals(split)[1]
+	require.True(t, gap <= 1 && gap >= -1)
+}